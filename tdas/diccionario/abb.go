@@ -4,6 +4,8 @@ import (
 	TDAPila "tdas/pila"
 )
 
+const MENSAJE_FUERA_DE_RANGO = "La posicion esta fuera de rango"
+
 // funcion de comparacion
 type funcCmp[K any] func(K, K) int
 
@@ -12,19 +14,29 @@ type nodoAbb[K any, V any] struct {
 	derecho   *nodoAbb[K, V]
 	clave     K
 	dato      V
+	tamanio   int  // cantidad de nodos del subárbol, incluyendo a este
+	altura    int  // altura del subárbol, sólo la mantiene el AVL
+	rojo      bool // color del nodo, sólo lo usa el árbol rojo-negro
 }
 
 type abb[K any, V any] struct {
-	raiz     *nodoAbb[K, V]
-	cantidad int
-	cmp      funcCmp[K]
+	raiz           *nodoAbb[K, V]
+	cantidad       int
+	cmp            funcCmp[K]
+	modificaciones int
 }
 
+// iterAbb recorre el árbol en orden guardando en una pila los nodos que faltan visitar. Los recorridos del ABB
+// usan esta pila, u otras armadas igual, en lugar de recursión: un ABB sin balancear puede ser tan alto como
+// su cantidad de nodos.
 type iterAbb[K any, V any] struct {
-	pila  TDAPila.Pila[*nodoAbb[K, V]]
-	cmp   func(K, K) int
-	desde *K
-	hasta *K
+	abb            *abb[K, V]
+	pila           TDAPila.Pila[*nodoAbb[K, V]]
+	cmp            func(K, K) int
+	desde          *K
+	hasta          *K
+	inverso        bool // recorre de la mayor clave a la menor
+	modificaciones int
 }
 
 // CrearABB crea un nuevo ABB vacío con la func de cmp
@@ -42,9 +54,11 @@ func (a *abb[K, V]) Guardar(clave K, dato V) {
 		return
 	}
 
-	// Caso 2: La clave no existe. Creamos un nuevo nodo.
-	nuevoNodo := &nodoAbb[K, V]{clave: clave, dato: dato}
+	// Caso 2: La clave no existe. Creamos un nuevo nodo, que agranda a todos los subárboles en su camino.
+	nuevoNodo := &nodoAbb[K, V]{clave: clave, dato: dato, tamanio: 1}
 	a.cantidad++
+	a.modificaciones++
+	a.sumarEnCamino(clave, 1)
 
 	if padre == nil {
 		a.raiz = nuevoNodo
@@ -73,24 +87,23 @@ func (a *abb[K, V]) Obtener(clave K) V {
 	return nodo.dato
 }
 
+// buscarNodo baja desde nodo hasta encontrar la clave, devolviendo también su padre. Si la clave no está,
+// devuelve nil y el nodo del que colgaría.
 func (a *abb[K, V]) buscarNodo(nodo *nodoAbb[K, V], clave K) (padre *nodoAbb[K, V], encontrado *nodoAbb[K, V]) {
-	return a.buscarNodoAux(nil, nodo, clave)
-}
-
-func (a *abb[K, V]) buscarNodoAux(padre *nodoAbb[K, V], nodo *nodoAbb[K, V], clave K) (*nodoAbb[K, V], *nodoAbb[K, V]) {
-	if nodo == nil {
-		return padre, nil
-	}
-
-	comp := a.cmp(clave, nodo.clave)
-	if comp == 0 {
-		return padre, nodo
-	}
+	for nodo != nil {
+		comp := a.cmp(clave, nodo.clave)
+		if comp == 0 {
+			return padre, nodo
+		}
 
-	if comp < 0 {
-		return a.buscarNodoAux(nodo, nodo.izquierdo, clave)
+		padre = nodo
+		if comp < 0 {
+			nodo = nodo.izquierdo
+		} else {
+			nodo = nodo.derecho
+		}
 	}
-	return a.buscarNodoAux(nodo, nodo.derecho, clave)
+	return padre, nil
 }
 
 // Borrar elimina una clave del ABB y devuelve su valor
@@ -103,6 +116,8 @@ func (a *abb[K, V]) Borrar(clave K) V {
 
 	dato := nodo.dato
 	a.cantidad--
+	a.modificaciones++
+	a.sumarEnCamino(clave, -1)
 
 	if nodo.izquierdo == nil {
 		// Caso 1: 0 hijos o 1 hijo (derecho)
@@ -113,6 +128,11 @@ func (a *abb[K, V]) Borrar(clave K) V {
 	} else {
 		// Caso 3: 2 hijos
 		padreSucesor, sucesor := a.buscarMinimoConPadre(nodo.derecho, nodo)
+		// Los subárboles en el camino al sucesor pierden un nodo
+		nodo.tamanio--
+		for actual := nodo.derecho; actual != sucesor; actual = actual.izquierdo {
+			actual.tamanio--
+		}
 
 		nodo.clave, nodo.dato = sucesor.clave, sucesor.dato
 
@@ -132,6 +152,23 @@ func (a *abb[K, V]) reemplazarHijo(padre *nodoAbb[K, V], nodo *nodoAbb[K, V], re
 	}
 }
 
+// sumarEnCamino suma delta al tamaño de los nodos en el camino desde la raíz hasta la clave, sin incluirla
+func (a *abb[K, V]) sumarEnCamino(clave K, delta int) {
+	nodo := a.raiz
+	for nodo != nil {
+		comp := a.cmp(clave, nodo.clave)
+		if comp == 0 {
+			return
+		}
+		nodo.tamanio += delta
+		if comp < 0 {
+			nodo = nodo.izquierdo
+		} else {
+			nodo = nodo.derecho
+		}
+	}
+}
+
 func (a *abb[K, V]) buscarMinimoConPadre(nodo *nodoAbb[K, V], padre *nodoAbb[K, V]) (*nodoAbb[K, V], *nodoAbb[K, V]) {
 	actual := nodo
 	for actual.izquierdo != nil {
@@ -146,40 +183,192 @@ func (a *abb[K, V]) Cantidad() int {
 	return a.cantidad
 }
 
-// Iterador interno
-func (a *abb[K, V]) Iterar(visitar func(K, V) bool) {
-	a.iterarRango(a.raiz, nil, nil, visitar)
+func tamanio[K any, V any](nodo *nodoAbb[K, V]) int {
+	if nodo == nil {
+		return 0
+	}
+	return nodo.tamanio
 }
 
-func (a *abb[K, V]) IterarRango(desde, hasta *K, visitar func(K, V) bool) {
-	a.iterarRango(a.raiz, desde, hasta, visitar)
+func actualizarTamanio[K any, V any](nodo *nodoAbb[K, V]) {
+	nodo.tamanio = tamanio(nodo.izquierdo) + tamanio(nodo.derecho) + 1
 }
 
-// Func aux que recorre el arbol recursivamente y ve solo las claves dentro del rango
-func (a *abb[K, V]) iterarRango(nodo *nodoAbb[K, V], desde, hasta *K, visitar func(K, V) bool) bool {
-	if nodo == nil {
-		return true
+// Seleccionar devuelve la k-ésima clave en orden, usando el tamaño de los subárboles para decidir hacia dónde
+// bajar
+func (a *abb[K, V]) Seleccionar(k int) (K, V) {
+	if k < 0 || k >= a.cantidad {
+		panic(MENSAJE_FUERA_DE_RANGO)
 	}
 
-	if desde == nil || a.cmp(nodo.clave, *desde) >= 0 {
-		if !a.iterarRango(nodo.izquierdo, desde, hasta, visitar) {
-			return false
+	nodo := a.raiz
+	for {
+		izquierdos := tamanio(nodo.izquierdo)
+		if k == izquierdos {
+			return nodo.clave, nodo.dato
+		}
+		if k < izquierdos {
+			nodo = nodo.izquierdo
+		} else {
+			k -= izquierdos + 1
+			nodo = nodo.derecho
 		}
 	}
+}
 
-	if (desde == nil || a.cmp(nodo.clave, *desde) >= 0) && (hasta == nil || a.cmp(nodo.clave, *hasta) <= 0) {
-		if !visitar(nodo.clave, nodo.dato) {
-			return false
+// Rango devuelve cuántas claves son menores a la clave dada
+func (a *abb[K, V]) Rango(clave K) int {
+	return a.contarMenores(clave, false)
+}
+
+// ContarRango devuelve cuántas claves hay entre desde y hasta, incluyéndolos
+func (a *abb[K, V]) ContarRango(desde, hasta *K) int {
+	cantidad := a.cantidad
+	if hasta != nil {
+		cantidad = a.contarMenores(*hasta, true)
+	}
+	if desde != nil {
+		cantidad -= a.contarMenores(*desde, false)
+	}
+	return max(cantidad, 0)
+}
+
+// contarMenores cuenta las claves menores a la dada, y también la clave misma si incluirla es true
+func (a *abb[K, V]) contarMenores(clave K, incluirla bool) int {
+	cantidad := 0
+	nodo := a.raiz
+	for nodo != nil {
+		comp := a.cmp(clave, nodo.clave)
+		if comp < 0 {
+			nodo = nodo.izquierdo
+			continue
+		}
+		if comp == 0 {
+			cantidad += tamanio(nodo.izquierdo)
+			if incluirla {
+				cantidad++
+			}
+			return cantidad
 		}
+		cantidad += tamanio(nodo.izquierdo) + 1
+		nodo = nodo.derecho
+	}
+	return cantidad
+}
+
+// Minimo devuelve la menor clave, o false si el ABB está vacío
+func (a *abb[K, V]) Minimo() (K, V, bool) {
+	if a.raiz == nil {
+		return resultadoNodo[K, V](nil)
+	}
+	_, minimo := a.buscarMinimoConPadre(a.raiz, nil)
+	return resultadoNodo(minimo)
+}
+
+// Maximo devuelve la mayor clave, o false si el ABB está vacío
+func (a *abb[K, V]) Maximo() (K, V, bool) {
+	nodo := a.raiz
+	for nodo != nil && nodo.derecho != nil {
+		nodo = nodo.derecho
 	}
+	return resultadoNodo(nodo)
+}
+
+// Piso devuelve la mayor clave menor o igual a la dada
+func (a *abb[K, V]) Piso(clave K) (K, V, bool) {
+	return resultadoNodo(a.mayorMenorA(clave, true))
+}
+
+// Techo devuelve la menor clave mayor o igual a la dada
+func (a *abb[K, V]) Techo(clave K) (K, V, bool) {
+	return resultadoNodo(a.menorMayorA(clave, true))
+}
+
+// Predecesor devuelve la mayor clave estrictamente menor a la dada
+func (a *abb[K, V]) Predecesor(clave K) (K, V, bool) {
+	return resultadoNodo(a.mayorMenorA(clave, false))
+}
 
-	if hasta == nil || a.cmp(nodo.clave, *hasta) <= 0 {
-		if !a.iterarRango(nodo.derecho, desde, hasta, visitar) {
-			return false
+// Sucesor devuelve la menor clave estrictamente mayor a la dada
+func (a *abb[K, V]) Sucesor(clave K) (K, V, bool) {
+	return resultadoNodo(a.menorMayorA(clave, false))
+}
+
+func resultadoNodo[K any, V any](nodo *nodoAbb[K, V]) (K, V, bool) {
+	if nodo == nil {
+		var clave K
+		var dato V
+		return clave, dato, false
+	}
+	return nodo.clave, nodo.dato, true
+}
+
+// mayorMenorA baja desde la raíz buscando la clave, y recuerda el último nodo menor a ella (o igual, si
+// incluirla es true) por el que se fue a la derecha
+func (a *abb[K, V]) mayorMenorA(clave K, incluirla bool) *nodoAbb[K, V] {
+	var candidato *nodoAbb[K, V]
+	nodo := a.raiz
+	for nodo != nil {
+		comp := a.cmp(nodo.clave, clave)
+		if comp == 0 && incluirla {
+			return nodo
+		}
+		if comp < 0 {
+			candidato = nodo
+			nodo = nodo.derecho
+		} else {
+			nodo = nodo.izquierdo
 		}
 	}
+	return candidato
+}
 
-	return true
+// menorMayorA es la búsqueda simétrica a mayorMenorA
+func (a *abb[K, V]) menorMayorA(clave K, incluirla bool) *nodoAbb[K, V] {
+	var candidato *nodoAbb[K, V]
+	nodo := a.raiz
+	for nodo != nil {
+		comp := a.cmp(nodo.clave, clave)
+		if comp == 0 && incluirla {
+			return nodo
+		}
+		if comp > 0 {
+			candidato = nodo
+			nodo = nodo.izquierdo
+		} else {
+			nodo = nodo.derecho
+		}
+	}
+	return candidato
+}
+
+// Iterador interno
+func (a *abb[K, V]) Iterar(visitar func(K, V) bool) {
+	a.iterarRango(nil, nil, false, visitar)
+}
+
+func (a *abb[K, V]) IterarRango(desde, hasta *K, visitar func(K, V) bool) {
+	a.iterarRango(desde, hasta, false, visitar)
+}
+
+func (a *abb[K, V]) IterarInverso(visitar func(K, V) bool) {
+	a.iterarRango(nil, nil, true, visitar)
+}
+
+func (a *abb[K, V]) IterarRangoInverso(desde, hasta *K, visitar func(K, V) bool) {
+	a.iterarRango(desde, hasta, true, visitar)
+}
+
+// Func aux que recorre el rango con el iterador externo
+func (a *abb[K, V]) iterarRango(desde, hasta *K, inverso bool, visitar func(K, V) bool) {
+	iter := a.crearIterador(desde, hasta, inverso)
+	for iter.HaySiguiente() {
+		nodo := iter.pila.VerTope()
+		if !visitar(nodo.clave, nodo.dato) {
+			return
+		}
+		iter.avanzar()
+	}
 }
 
 // Iterador externo
@@ -189,8 +378,30 @@ func (a *abb[K, V]) Iterador() IterDiccionario[K, V] {
 }
 
 func (a *abb[K, V]) IteradorRango(desde, hasta *K) IterDiccionario[K, V] {
-	iter := &iterAbb[K, V]{pila: TDAPila.CrearPilaDinamica[*nodoAbb[K, V]](), cmp: a.cmp, desde: desde, hasta: hasta}
-	iter.apilarIzquierdos(a.raiz, desde)
+	return a.crearIterador(desde, hasta, false)
+}
+
+// IteradorRangoInverso recorre las claves entre desde y hasta de la mayor a la menor. La pila guarda el camino
+// hacia la mayor clave que falta ver, como el iterador común guarda el camino hacia la menor.
+func (a *abb[K, V]) IteradorRangoInverso(desde, hasta *K) IterDiccionario[K, V] {
+	return a.crearIterador(desde, hasta, true)
+}
+
+func (a *abb[K, V]) crearIterador(desde, hasta *K, inverso bool) *iterAbb[K, V] {
+	iter := &iterAbb[K, V]{
+		abb:            a,
+		pila:           TDAPila.CrearPilaDinamica[*nodoAbb[K, V]](),
+		cmp:            a.cmp,
+		desde:          desde,
+		hasta:          hasta,
+		inverso:        inverso,
+		modificaciones: a.modificaciones,
+	}
+	if inverso {
+		iter.apilarDerechos(a.raiz, hasta)
+	} else {
+		iter.apilarIzquierdos(a.raiz, desde)
+	}
 	return iter
 }
 
@@ -214,18 +425,42 @@ func (iter *iterAbb[K, V]) apilarIzquierdos(nodo *nodoAbb[K, V], desde *K) {
 	}
 }
 
+// apilarDerechos es el simétrico de apilarIzquierdos, para el iterador inverso
+func (iter *iterAbb[K, V]) apilarDerechos(nodo *nodoAbb[K, V], hasta *K) {
+	for nodo != nil {
+		if hasta == nil || iter.cmp(nodo.clave, *hasta) <= 0 {
+			iter.pila.Apilar(nodo)
+			nodo = nodo.derecho
+		} else {
+			nodo = nodo.izquierdo
+		}
+	}
+}
+
 func (iter *iterAbb[K, V]) HaySiguiente() bool {
 	if iter.pila.EstaVacia() {
 		return false
 	}
 	tope := iter.pila.VerTope()
+	if iter.inverso {
+		return iter.desde == nil || iter.cmp(tope.clave, *iter.desde) >= 0
+	}
 	if iter.hasta != nil && iter.cmp(tope.clave, *iter.hasta) > 0 {
 		return false
 	}
 	return true
 }
 
+// verificarNoModificado entra en pánico si se guardó o borró alguna clave desde que se creó el iterador,
+// ya que los nodos apilados pueden haber cambiado
+func (iter *iterAbb[K, V]) verificarNoModificado() {
+	if iter.modificaciones != iter.abb.modificaciones {
+		panic(MENSAJE_DICC_MODIFICADO)
+	}
+}
+
 func (iter *iterAbb[K, V]) VerActual() (K, V) {
+	iter.verificarNoModificado()
 	if !iter.HaySiguiente() {
 		panic(MENSAJE_ITER_TERMINADO)
 	}
@@ -233,14 +468,21 @@ func (iter *iterAbb[K, V]) VerActual() (K, V) {
 	return nodo.clave, nodo.dato
 }
 
-// Siguiente avanza al siguiente elemento en el recorrido inorder
+// Siguiente avanza al siguiente elemento en el recorrido inorder (o inorder inverso)
 func (iter *iterAbb[K, V]) Siguiente() {
+	iter.verificarNoModificado()
 	if !iter.HaySiguiente() {
 		panic(MENSAJE_ITER_TERMINADO)
 	}
+	iter.avanzar()
+}
+
+func (iter *iterAbb[K, V]) avanzar() {
 	nodo := iter.pila.Desapilar()
 
-	if nodo.derecho != nil {
+	if iter.inverso {
+		iter.apilarDerechos(nodo.izquierdo, nil)
+	} else if nodo.derecho != nil {
 		iter.apilarIzquierdos(nodo.derecho, nil)
 	}
 }
@@ -0,0 +1,95 @@
+package diccionario
+
+const (
+	MENSAJE_MISMO_DICC          = "No se puede unir un diccionario consigo mismo"
+	MENSAJE_DICC_INCOMPATIBLE   = "Los diccionarios no son de la misma implementacion"
+	MENSAJE_CLAVES_SUPERPUESTAS = "Las claves de los diccionarios se superponen"
+)
+
+// Dividir reparte los nodos entre dos ABB nuevos siguiendo el camino de búsqueda de la clave: cada nodo del
+// camino se queda, junto con uno de sus subárboles, del lado que le corresponde. Es O(h), así que en el peor
+// caso es O(n).
+func (a *abb[K, V]) Dividir(clave K) (DiccionarioOrdenado[K, V], DiccionarioOrdenado[K, V]) {
+	var menores, mayores *nodoAbb[K, V]
+	// Lugares donde se engancha el próximo nodo de cada lado
+	finMenores, finMayores := &menores, &mayores
+	var camino []*nodoAbb[K, V]
+
+	for nodo := a.raiz; nodo != nil; {
+		camino = append(camino, nodo)
+		if a.cmp(nodo.clave, clave) < 0 {
+			*finMenores = nodo
+			finMenores = &nodo.derecho
+			nodo = nodo.derecho
+		} else {
+			*finMayores = nodo
+			finMayores = &nodo.izquierdo
+			nodo = nodo.izquierdo
+		}
+	}
+	*finMenores, *finMayores = nil, nil
+
+	// Cada nodo del camino sólo puede haber cambiado de hijos por otros que están más abajo en el camino
+	for i := len(camino) - 1; i >= 0; i-- {
+		actualizarTamanio(camino[i])
+	}
+	a.vaciar()
+	return &abb[K, V]{raiz: menores, cantidad: tamanio(menores), cmp: a.cmp},
+		&abb[K, V]{raiz: mayores, cantidad: tamanio(mayores), cmp: a.cmp}
+}
+
+// Unir cuelga al árbol de claves mayores como hijo derecho de la mayor clave del otro. Es O(h).
+func (a *abb[K, V]) Unir(otro DiccionarioOrdenado[K, V]) {
+	abbOtro, ok := otro.(*abb[K, V])
+	if !ok {
+		panic(MENSAJE_DICC_INCOMPATIBLE)
+	}
+	menor, mayor := a.ordenarParaUnir(abbOtro)
+	if menor == nil {
+		a.raiz = mayor
+	} else {
+		a.raiz = menor
+		nodo := menor
+		for ; nodo.derecho != nil; nodo = nodo.derecho {
+			nodo.tamanio += tamanio(mayor)
+		}
+		nodo.derecho = mayor
+		actualizarTamanio(nodo)
+	}
+	a.absorber(abbOtro)
+}
+
+// ordenarParaUnir valida que se pueda unir con otro y devuelve primero la raíz del árbol de claves menores
+func (a *abb[K, V]) ordenarParaUnir(otro *abb[K, V]) (*nodoAbb[K, V], *nodoAbb[K, V]) {
+	if a == otro {
+		panic(MENSAJE_MISMO_DICC)
+	}
+	if a.raiz == nil || otro.raiz == nil {
+		return a.raiz, otro.raiz
+	}
+	minimo, _, _ := a.Minimo()
+	maximo, _, _ := a.Maximo()
+	minimoOtro, _, _ := otro.Minimo()
+	maximoOtro, _, _ := otro.Maximo()
+	switch {
+	case a.cmp(maximo, minimoOtro) < 0:
+		return a.raiz, otro.raiz
+	case a.cmp(maximoOtro, minimo) < 0:
+		return otro.raiz, a.raiz
+	}
+	panic(MENSAJE_CLAVES_SUPERPUESTAS)
+}
+
+// absorber suma a la cantidad los nodos de otro, que ya forman parte del árbol, y deja vacío a otro
+func (a *abb[K, V]) absorber(otro *abb[K, V]) {
+	a.cantidad += otro.cantidad
+	a.modificaciones++
+	otro.vaciar()
+}
+
+// vaciar deja al árbol sin nodos, invalidando a los iteradores existentes
+func (a *abb[K, V]) vaciar() {
+	a.raiz = nil
+	a.cantidad = 0
+	a.modificaciones++
+}
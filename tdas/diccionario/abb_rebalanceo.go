@@ -0,0 +1,73 @@
+package diccionario
+
+import TDAPila "tdas/pila"
+
+const (
+	MENSAJE_LARGOS_DISTINTOS    = "Las claves y los datos tienen distinto largo"
+	MENSAJE_CLAVES_DESORDENADAS = "Las claves no estan ordenadas"
+)
+
+// CrearABBDesdeOrdenados crea un ABB perfectamente balanceado con las claves dadas, que deben estar en orden
+// estrictamente creciente según cmp, en tiempo lineal. datos[i] es el dato de claves[i].
+func CrearABBDesdeOrdenados[K any, V any](claves []K, datos []V, cmp func(K, K) int) DiccionarioOrdenado[K, V] {
+	if len(claves) != len(datos) {
+		panic(MENSAJE_LARGOS_DISTINTOS)
+	}
+
+	nodos := make([]*nodoAbb[K, V], len(claves))
+	for i := range claves {
+		if i > 0 && cmp(claves[i-1], claves[i]) >= 0 {
+			panic(MENSAJE_CLAVES_DESORDENADAS)
+		}
+		nodos[i] = &nodoAbb[K, V]{clave: claves[i], dato: datos[i]}
+	}
+	return &abb[K, V]{raiz: construirBalanceado(nodos), cantidad: len(nodos), cmp: cmp}
+}
+
+// CrearABBDesdeIterador crea un ABB perfectamente balanceado con lo que queda por recorrer del iterador, que
+// debe devolver las claves en orden según cmp (por ejemplo, el iterador de otro DiccionarioOrdenado)
+func CrearABBDesdeIterador[K any, V any](iter IterDiccionario[K, V], cmp func(K, K) int) DiccionarioOrdenado[K, V] {
+	var claves []K
+	var datos []V
+	for ; iter.HaySiguiente(); iter.Siguiente() {
+		clave, dato := iter.VerActual()
+		claves = append(claves, clave)
+		datos = append(datos, dato)
+	}
+	return CrearABBDesdeOrdenados(claves, datos, cmp)
+}
+
+// Rebalancear reacomoda los nodos del árbol para que quede perfectamente balanceado, sin crear nodos nuevos
+func (a *abb[K, V]) Rebalancear() {
+	a.raiz = construirBalanceado(a.nodosEnOrden())
+	a.modificaciones++
+}
+
+// nodosEnOrden devuelve los nodos del árbol ordenados por clave
+func (a *abb[K, V]) nodosEnOrden() []*nodoAbb[K, V] {
+	nodos := make([]*nodoAbb[K, V], 0, a.cantidad)
+	pila := TDAPila.CrearPilaDinamica[*nodoAbb[K, V]]()
+	for nodo := a.raiz; nodo != nil || !pila.EstaVacia(); {
+		for ; nodo != nil; nodo = nodo.izquierdo {
+			pila.Apilar(nodo)
+		}
+		nodo = pila.Desapilar()
+		nodos = append(nodos, nodo)
+		nodo = nodo.derecho
+	}
+	return nodos
+}
+
+// construirBalanceado enlaza los nodos, que deben estar ordenados, tomando como raíz de cada subárbol al nodo
+// del medio. Actualiza tamaños y alturas, por lo que el resultado también es un AVL válido.
+func construirBalanceado[K any, V any](nodos []*nodoAbb[K, V]) *nodoAbb[K, V] {
+	if len(nodos) == 0 {
+		return nil
+	}
+	medio := len(nodos) / 2
+	raiz := nodos[medio]
+	raiz.izquierdo = construirBalanceado(nodos[:medio])
+	raiz.derecho = construirBalanceado(nodos[medio+1:])
+	actualizarAltura(raiz)
+	return raiz
+}
@@ -0,0 +1,201 @@
+package diccionario
+
+// AVL: un ABB que después de cada Guardar o Borrar rota los nodos cuyos subárboles difieren en más de uno de
+// altura. Así la altura del árbol es siempre O(log n), incluso si las claves se insertan ordenadas. Comparte
+// los nodos y los iteradores con el ABB; sólo cambian las operaciones que modifican el árbol.
+
+type avl[K any, V any] struct {
+	abb[K, V]
+}
+
+// CrearAVL crea un nuevo AVL vacío con la func de cmp
+func CrearAVL[K any, V any](cmp func(K, K) int) DiccionarioOrdenado[K, V] {
+	return &avl[K, V]{abb[K, V]{cmp: cmp}}
+}
+
+// Guardar inserta o reemplaza una clave, rebalanceando el camino desde la nueva hoja hasta la raíz
+func (a *avl[K, V]) Guardar(clave K, dato V) {
+	a.raiz = a.guardar(a.raiz, clave, dato)
+}
+
+func (a *avl[K, V]) guardar(nodo *nodoAbb[K, V], clave K, dato V) *nodoAbb[K, V] {
+	if nodo == nil {
+		a.cantidad++
+		a.modificaciones++
+		return &nodoAbb[K, V]{clave: clave, dato: dato, tamanio: 1, altura: 1}
+	}
+
+	comp := a.cmp(clave, nodo.clave)
+	if comp == 0 {
+		nodo.dato = dato
+		return nodo
+	}
+	if comp < 0 {
+		nodo.izquierdo = a.guardar(nodo.izquierdo, clave, dato)
+	} else {
+		nodo.derecho = a.guardar(nodo.derecho, clave, dato)
+	}
+	return balancear(nodo)
+}
+
+// Borrar elimina una clave del AVL y devuelve su valor, rebalanceando el camino hasta la raíz
+func (a *avl[K, V]) Borrar(clave K) V {
+	var dato V
+	a.raiz = a.borrar(a.raiz, clave, &dato)
+	a.cantidad--
+	a.modificaciones++
+	return dato
+}
+
+func (a *avl[K, V]) borrar(nodo *nodoAbb[K, V], clave K, dato *V) *nodoAbb[K, V] {
+	if nodo == nil {
+		panic(MENSAJE_CLAVE_INEXIST)
+	}
+
+	comp := a.cmp(clave, nodo.clave)
+	if comp < 0 {
+		nodo.izquierdo = a.borrar(nodo.izquierdo, clave, dato)
+	} else if comp > 0 {
+		nodo.derecho = a.borrar(nodo.derecho, clave, dato)
+	} else {
+		*dato = nodo.dato
+		if nodo.izquierdo == nil {
+			return nodo.derecho
+		}
+		if nodo.derecho == nil {
+			return nodo.izquierdo
+		}
+		// Con 2 hijos, el nodo pasa a tener la clave del sucesor, que se borra del subárbol derecho
+		var sucesor *nodoAbb[K, V]
+		nodo.derecho = borrarMinimo(nodo.derecho, &sucesor)
+		nodo.clave, nodo.dato = sucesor.clave, sucesor.dato
+	}
+	return balancear(nodo)
+}
+
+// borrarMinimo saca del subárbol al nodo con la menor clave, devolviéndolo en minimo
+func borrarMinimo[K any, V any](nodo *nodoAbb[K, V], minimo **nodoAbb[K, V]) *nodoAbb[K, V] {
+	if nodo.izquierdo == nil {
+		*minimo = nodo
+		return nodo.derecho
+	}
+	nodo.izquierdo = borrarMinimo(nodo.izquierdo, minimo)
+	return balancear(nodo)
+}
+
+func altura[K any, V any](nodo *nodoAbb[K, V]) int {
+	if nodo == nil {
+		return 0
+	}
+	return nodo.altura
+}
+
+// actualizarAltura recalcula la altura y el tamaño del nodo a partir de los de sus hijos
+func actualizarAltura[K any, V any](nodo *nodoAbb[K, V]) {
+	nodo.altura = max(altura(nodo.izquierdo), altura(nodo.derecho)) + 1
+	actualizarTamanio(nodo)
+}
+
+// factorBalance es positivo si el subárbol izquierdo es más alto, y negativo si lo es el derecho
+func factorBalance[K any, V any](nodo *nodoAbb[K, V]) int {
+	return altura(nodo.izquierdo) - altura(nodo.derecho)
+}
+
+// rotarDerecha sube al hijo izquierdo al lugar del nodo, que pasa a ser su hijo derecho. Devuelve la nueva raíz
+// del subárbol.
+func rotarDerecha[K any, V any](nodo *nodoAbb[K, V]) *nodoAbb[K, V] {
+	izq := nodo.izquierdo
+	nodo.izquierdo = izq.derecho
+	izq.derecho = nodo
+	actualizarAltura(nodo)
+	actualizarAltura(izq)
+	return izq
+}
+
+// rotarIzquierda es la rotación simétrica, subiendo al hijo derecho
+func rotarIzquierda[K any, V any](nodo *nodoAbb[K, V]) *nodoAbb[K, V] {
+	der := nodo.derecho
+	nodo.derecho = der.izquierdo
+	der.izquierdo = nodo
+	actualizarAltura(nodo)
+	actualizarAltura(der)
+	return der
+}
+
+// balancear actualiza la altura del nodo y, si sus subárboles difieren en más de uno, lo rota. Devuelve la
+// nueva raíz del subárbol.
+func balancear[K any, V any](nodo *nodoAbb[K, V]) *nodoAbb[K, V] {
+	actualizarAltura(nodo)
+	balance := factorBalance(nodo)
+
+	if balance > 1 {
+		// Caso izquierda-derecha: primero se lleva el desbalance al lado izquierdo del hijo
+		if factorBalance(nodo.izquierdo) < 0 {
+			nodo.izquierdo = rotarIzquierda(nodo.izquierdo)
+		}
+		return rotarDerecha(nodo)
+	}
+	if balance < -1 {
+		if factorBalance(nodo.derecho) > 0 {
+			nodo.derecho = rotarDerecha(nodo.derecho)
+		}
+		return rotarIzquierda(nodo)
+	}
+	return nodo
+}
+
+// Dividir parte el árbol bajando por el camino de búsqueda de la clave y volviendo a unir, con unirAVL, los
+// subárboles que quedan de cada lado. Como las alturas de lo que se va uniendo crecen a lo largo del camino, el
+// costo total es O(log n).
+func (a *avl[K, V]) Dividir(clave K) (DiccionarioOrdenado[K, V], DiccionarioOrdenado[K, V]) {
+	menores, mayores := a.dividir(a.raiz, clave)
+	a.vaciar()
+	return &avl[K, V]{abb[K, V]{raiz: menores, cantidad: tamanio(menores), cmp: a.cmp}},
+		&avl[K, V]{abb[K, V]{raiz: mayores, cantidad: tamanio(mayores), cmp: a.cmp}}
+}
+
+func (a *avl[K, V]) dividir(nodo *nodoAbb[K, V], clave K) (*nodoAbb[K, V], *nodoAbb[K, V]) {
+	if nodo == nil {
+		return nil, nil
+	}
+	if a.cmp(nodo.clave, clave) < 0 {
+		menores, mayores := a.dividir(nodo.derecho, clave)
+		return unirAVL(nodo.izquierdo, nodo, menores), mayores
+	}
+	menores, mayores := a.dividir(nodo.izquierdo, clave)
+	return menores, unirAVL(mayores, nodo, nodo.derecho)
+}
+
+// Unir saca la menor clave del árbol de claves mayores y la usa de raíz para unir ambos árboles. Es O(log n).
+func (a *avl[K, V]) Unir(otro DiccionarioOrdenado[K, V]) {
+	avlOtro, ok := otro.(*avl[K, V])
+	if !ok {
+		panic(MENSAJE_DICC_INCOMPATIBLE)
+	}
+	menor, mayor := a.ordenarParaUnir(&avlOtro.abb)
+	if mayor == nil {
+		a.raiz = menor
+	} else {
+		var medio *nodoAbb[K, V]
+		mayor = borrarMinimo(mayor, &medio)
+		a.raiz = unirAVL(menor, medio, mayor)
+	}
+	a.absorber(&avlOtro.abb)
+}
+
+// unirAVL arma un AVL con las claves de izq, la de medio y las de der, en ese orden. Baja por el borde del
+// árbol más alto hasta encontrar un subárbol de altura parecida a la del otro, cuelga ahí a medio y rebalancea
+// al volver, como si fuera una inserción. Es O(1 + |altura(izq) - altura(der)|).
+func unirAVL[K any, V any](izq, medio, der *nodoAbb[K, V]) *nodoAbb[K, V] {
+	switch {
+	case altura(izq) > altura(der)+1:
+		izq.derecho = unirAVL(izq.derecho, medio, der)
+		return balancear(izq)
+	case altura(der) > altura(izq)+1:
+		der.izquierdo = unirAVL(izq, medio, der.izquierdo)
+		return balancear(der)
+	}
+	medio.izquierdo, medio.derecho = izq, der
+	actualizarAltura(medio)
+	return medio
+}
@@ -23,7 +23,9 @@ type Diccionario[K any, V any] interface {
 	// mismo
 	Iterar(func(clave K, dato V) bool)
 
-	// Iterador devuelve un IterDiccionario para este Diccionario
+	// Iterador devuelve un IterDiccionario para este Diccionario. Si se guarda una clave nueva o se borra alguna
+	// mientras el iterador sigue en uso, VerActual y Siguiente entran en pánico con un mensaje
+	// 'El diccionario fue modificado durante la iteracion'
 	Iterador() IterDiccionario[K, V]
 }
 
@@ -9,4 +9,58 @@ type DiccionarioOrdenado[K any, V any] interface {
 
 	// IteradorRango crea un IterDiccionario que sólo itere por las claves que se encuentren en el rango indicado
 	IteradorRango(desde *K, hasta *K) IterDiccionario[K, V]
+
+	// IterarInverso itera internamente el diccionario de la mayor clave a la menor
+	IterarInverso(visitar func(clave K, dato V) bool)
+
+	// IterarRangoInverso es como IterarRango, pero recorriendo de hasta a desde
+	IterarRangoInverso(desde *K, hasta *K, visitar func(clave K, dato V) bool)
+
+	// IteradorRangoInverso crea un IterDiccionario que recorre las claves del rango indicado de la mayor a la
+	// menor. desde sigue siendo el extremo menor del rango
+	IteradorRangoInverso(desde *K, hasta *K) IterDiccionario[K, V]
+
+	// Seleccionar devuelve la clave y el dato que ocupan la posición k (empezando en 0) en el orden de las claves.
+	// Si k no está entre 0 y Cantidad()-1, debe entrar en pánico con mensaje 'La posicion esta fuera de rango'
+	Seleccionar(k int) (K, V)
+
+	// Rango devuelve la cantidad de claves menores a la indicada, que no necesita pertenecer al diccionario
+	Rango(clave K) int
+
+	// ContarRango devuelve la cantidad de claves comprendidas en el rango indicado, incluyendo a los extremos.
+	// Un extremo nil indica que el rango no está acotado de ese lado
+	ContarRango(desde *K, hasta *K) int
+
+	// Minimo devuelve la menor clave del diccionario y su dato. Si está vacío, devuelve false
+	Minimo() (K, V, bool)
+
+	// Maximo devuelve la mayor clave del diccionario y su dato. Si está vacío, devuelve false
+	Maximo() (K, V, bool)
+
+	// Piso devuelve la mayor clave menor o igual a la indicada, que no necesita pertenecer al diccionario. Si no
+	// hay ninguna, devuelve false
+	Piso(clave K) (K, V, bool)
+
+	// Techo devuelve la menor clave mayor o igual a la indicada. Si no hay ninguna, devuelve false
+	Techo(clave K) (K, V, bool)
+
+	// Predecesor devuelve la mayor clave estrictamente menor a la indicada. Si no hay ninguna, devuelve false
+	Predecesor(clave K) (K, V, bool)
+
+	// Sucesor devuelve la menor clave estrictamente mayor a la indicada. Si no hay ninguna, devuelve false
+	Sucesor(clave K) (K, V, bool)
+
+	// Rebalancear reorganiza el diccionario para que quede con la menor altura posible, por ejemplo después de
+	// guardar muchas claves en orden en un ABB. Invalida a los iteradores existentes
+	Rebalancear()
+
+	// Dividir reparte las claves en dos diccionarios nuevos de la misma implementación: uno con las menores a la
+	// indicada y otro con las mayores o iguales. Los nodos se mueven sin copiarse, así que el diccionario queda
+	// vacío
+	Dividir(clave K) (menores DiccionarioOrdenado[K, V], mayoresOIguales DiccionarioOrdenado[K, V])
+
+	// Unir agrega al diccionario todas las claves de otro, que queda vacío. Todas las claves de uno deben ser
+	// menores a todas las del otro; si no, entra en pánico con mensaje 'Las claves de los diccionarios se
+	// superponen'. Si otro es el mismo diccionario, o es de otra implementación, también entra en pánico
+	Unir(otro DiccionarioOrdenado[K, V])
 }
@@ -2,6 +2,7 @@ package diccionario_test
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"testing"
@@ -26,358 +27,435 @@ func cmpInts(a, b int) int {
 	return 0
 }
 
+var IMPLEMENTACIONES_ABB = []string{"ABB", "AVL", "Rojo-negro"}
+
+// crearABB crea un diccionario ordenado vacío de la implementación indicada, para correr las mismas pruebas
+// sobre todas
+func crearABB[K any, V any](impl string, cmp func(K, K) int) TDADiccionario.DiccionarioOrdenado[K, V] {
+	switch impl {
+	case "AVL":
+		return TDADiccionario.CrearAVL[K, V](cmp)
+	case "Rojo-negro":
+		return TDADiccionario.CrearArbolRojoNegro[K, V](cmp)
+	}
+	return TDADiccionario.CrearABB[K, V](cmp)
+}
+
+func paraCadaABB(t *testing.T, prueba func(t *testing.T, impl string)) {
+	for _, impl := range IMPLEMENTACIONES_ABB {
+		t.Run(impl, func(t *testing.T) {
+			prueba(t, impl)
+		})
+	}
+}
+
 var TAMS_VOLUMEN_PRUEBA = 50000
 var TAMS_VOLUMEN_ABB = []int{1000, 10000, 50000}
 
 func TestAbbVacio(t *testing.T) {
 	t.Log("Un ABB vacío no tiene claves ni elementos")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
 
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
-
-	require.EqualValues(t, 0, dic.Cantidad())
-	require.False(t, dic.Pertenece("A"))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+		require.EqualValues(t, 0, dic.Cantidad())
+		require.False(t, dic.Pertenece("A"))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	})
 }
 
 func TestGuardarYObtener(t *testing.T) {
 	t.Log("Guardar y obtener elementos correctamente")
-
-	dic := TDADiccionario.CrearABB[string, string](cmpStrings)
-	dic.Guardar("B", "b")
-	dic.Guardar("A", "a")
-	dic.Guardar("C", "c")
-
-	require.EqualValues(t, 3, dic.Cantidad())
-	require.True(t, dic.Pertenece("B"))
-	require.EqualValues(t, "b", dic.Obtener("B"))
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, string](impl, cmpStrings)
+		dic.Guardar("B", "b")
+		dic.Guardar("A", "a")
+		dic.Guardar("C", "c")
+
+		require.EqualValues(t, 3, dic.Cantidad())
+		require.True(t, dic.Pertenece("B"))
+		require.EqualValues(t, "b", dic.Obtener("B"))
+	})
 }
 
 func TestReemplazarDato(t *testing.T) {
 	t.Log("Reemplaza el dato si la clave ya existía")
-
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
-	dic.Guardar("uno", 1)
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.EqualValues(t, 1, dic.Obtener("uno"))
-
-	dic.Guardar("uno", 100)
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.EqualValues(t, 100, dic.Obtener("uno"))
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
+		dic.Guardar("uno", 1)
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.EqualValues(t, 1, dic.Obtener("uno"))
+
+		dic.Guardar("uno", 100)
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.EqualValues(t, 100, dic.Obtener("uno"))
+	})
 }
 
 func TestBorrarElementos(t *testing.T) {
 	t.Log("Borra claves correctamente en distintos casos")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
+		claves := []string{"D", "B", "A", "C", "F", "E", "G"}
+		for i, k := range claves {
+			dic.Guardar(k, i)
+		}
+		require.EqualValues(t, 7, dic.Cantidad())
 
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
-	claves := []string{"D", "B", "A", "C", "F", "E", "G"}
-	for i, k := range claves {
-		dic.Guardar(k, i)
-	}
-	require.EqualValues(t, 7, dic.Cantidad())
-
-	require.EqualValues(t, 0, dic.Borrar("D"))
-	require.EqualValues(t, 6, dic.Cantidad())
-	require.False(t, dic.Pertenece("D"))
+		require.EqualValues(t, 0, dic.Borrar("D"))
+		require.EqualValues(t, 6, dic.Cantidad())
+		require.False(t, dic.Pertenece("D"))
 
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("D") })
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("Z") })
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("D") })
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("Z") })
+	})
 }
 
 func TestIteradorExternoVacio(t *testing.T) {
 	t.Log("Iterador sobre ABB vacío")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
-	iter := dic.Iterador()
-	require.False(t, iter.HaySiguiente())
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
+		iter := dic.Iterador()
+		require.False(t, iter.HaySiguiente())
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+	})
 }
 
 func TestIteradorExternoOrdenado(t *testing.T) {
 	t.Log("Itera elementos en orden")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
-	claves := []string{"D", "B", "F", "A", "C", "E", "G"}
-	for i, k := range claves {
-		dic.Guardar(k, i)
-	}
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
+		claves := []string{"D", "B", "F", "A", "C", "E", "G"}
+		for i, k := range claves {
+			dic.Guardar(k, i)
+		}
 
-	iter := dic.Iterador()
-	esperado := []string{"A", "B", "C", "D", "E", "F", "G"}
-	i := 0
+		iter := dic.Iterador()
+		esperado := []string{"A", "B", "C", "D", "E", "F", "G"}
+		i := 0
 
-	for iter.HaySiguiente() {
-		clave, _ := iter.VerActual()
-		require.EqualValues(t, esperado[i], clave)
-		iter.Siguiente()
-		i++
-	}
-	require.EqualValues(t, len(esperado), i)
+		for iter.HaySiguiente() {
+			clave, _ := iter.VerActual()
+			require.EqualValues(t, esperado[i], clave)
+			iter.Siguiente()
+			i++
+		}
+		require.EqualValues(t, len(esperado), i)
+	})
 }
 
 func TestIteradorRango(t *testing.T) {
 	t.Log("Itera solo en el rango especificado")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
-	claves := []string{"A", "B", "C", "D", "E", "F", "G"}
-	for i, k := range claves {
-		dic.Guardar(k, i)
-	}
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
+		claves := []string{"A", "B", "C", "D", "E", "F", "G"}
+		for i, k := range claves {
+			dic.Guardar(k, i)
+		}
 
-	desde := "C"
-	hasta := "F"
-	iter := dic.IteradorRango(&desde, &hasta)
+		desde := "C"
+		hasta := "F"
+		iter := dic.IteradorRango(&desde, &hasta)
 
-	esperado := []string{"C", "D", "E", "F"}
-	i := 0
+		esperado := []string{"C", "D", "E", "F"}
+		i := 0
 
-	for iter.HaySiguiente() {
-		clave, _ := iter.VerActual()
-		require.EqualValues(t, esperado[i], clave)
-		iter.Siguiente()
-		i++
-	}
-	require.EqualValues(t, len(esperado), i)
+		for iter.HaySiguiente() {
+			clave, _ := iter.VerActual()
+			require.EqualValues(t, esperado[i], clave)
+			iter.Siguiente()
+			i++
+		}
+		require.EqualValues(t, len(esperado), i)
+	})
 }
 
 func TestABBConClavesNumericas(t *testing.T) {
 	t.Log("Valida que funcione con claves numéricas")
-	dic := TDADiccionario.CrearABB[int, string](cmpInts)
-
-	dic.Guardar(10, "diez")
-	dic.Guardar(5, "cinco")
-	dic.Guardar(15, "quince")
-
-	require.EqualValues(t, 3, dic.Cantidad())
-	require.True(t, dic.Pertenece(10))
-	require.EqualValues(t, "diez", dic.Obtener(10))
-	require.EqualValues(t, "cinco", dic.Borrar(5))
-	require.False(t, dic.Pertenece(5))
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, string](impl, cmpInts)
+
+		dic.Guardar(10, "diez")
+		dic.Guardar(5, "cinco")
+		dic.Guardar(15, "quince")
+
+		require.EqualValues(t, 3, dic.Cantidad())
+		require.True(t, dic.Pertenece(10))
+		require.EqualValues(t, "diez", dic.Obtener(10))
+		require.EqualValues(t, "cinco", dic.Borrar(5))
+		require.False(t, dic.Pertenece(5))
+	})
 }
 
 func TestABBValorNulo(t *testing.T) {
 	t.Log("Probamos que el valor puede ser nil sin problemas")
-	dic := TDADiccionario.CrearABB[string, *int](cmpStrings)
-	clave := "Pez"
-	dic.Guardar(clave, nil)
-	require.True(t, dic.Pertenece(clave))
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.EqualValues(t, (*int)(nil), dic.Obtener(clave))
-	require.EqualValues(t, (*int)(nil), dic.Borrar(clave))
-	require.False(t, dic.Pertenece(clave))
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, *int](impl, cmpStrings)
+		clave := "Pez"
+		dic.Guardar(clave, nil)
+		require.True(t, dic.Pertenece(clave))
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.EqualValues(t, (*int)(nil), dic.Obtener(clave))
+		require.EqualValues(t, (*int)(nil), dic.Borrar(clave))
+		require.False(t, dic.Pertenece(clave))
+	})
 }
 
 func TestBorrarCasosEspecificos(t *testing.T) {
 	t.Log("Verifica los tres casos de borrado: hoja, un hijo, dos hijos")
-	// Árbol:
-	//      D
-	//    /   \
-	//   B     F
-	//  / \   / \
-	// A   C E   G
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
-	dic.Guardar("D", 4)
-	dic.Guardar("B", 2)
-	dic.Guardar("F", 6)
-	dic.Guardar("A", 1)
-	dic.Guardar("C", 3)
-	dic.Guardar("E", 5)
-	dic.Guardar("G", 7)
-	require.EqualValues(t, 7, dic.Cantidad())
-
-	// Caso 1: Borrar Hoja (A)
-	dic.Borrar("A")
-	require.False(t, dic.Pertenece("A"))
-	require.EqualValues(t, 6, dic.Cantidad())
-
-	// Caso 2: Borrar nodo con 1 hijo (B, tiene a C)
-	// Árbol debe quedar:
-	//      D
-	//    /   \
-	//   C     F
-	//        / \
-	//       E   G
-	dic.Borrar("B")
-	require.False(t, dic.Pertenece("B"))
-	require.True(t, dic.Pertenece("C"))
-	require.EqualValues(t, 5, dic.Cantidad())
-
-	// Caso 3: Borrar nodo con 2 hijos (D, raíz)
-	// Sucesores 'E'.
-	// Árbol debe quedar:
-	//      E
-	//    /   \
-	//   C     F
-	//          \
-	//           G
-	dic.Borrar("D")
-	require.False(t, dic.Pertenece("D"))
-	require.EqualValues(t, 4, dic.Cantidad())
-	require.True(t, dic.Pertenece("E"))
-	require.True(t, dic.Pertenece("F"))
-	require.True(t, dic.Pertenece("C"))
-	require.True(t, dic.Pertenece("G"))
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		// Árbol:
+		//      D
+		//    /   \
+		//   B     F
+		//  / \   / \
+		// A   C E   G
+		dic := crearABB[string, int](impl, cmpStrings)
+		dic.Guardar("D", 4)
+		dic.Guardar("B", 2)
+		dic.Guardar("F", 6)
+		dic.Guardar("A", 1)
+		dic.Guardar("C", 3)
+		dic.Guardar("E", 5)
+		dic.Guardar("G", 7)
+		require.EqualValues(t, 7, dic.Cantidad())
+
+		// Caso 1: Borrar Hoja (A)
+		dic.Borrar("A")
+		require.False(t, dic.Pertenece("A"))
+		require.EqualValues(t, 6, dic.Cantidad())
+
+		// Caso 2: Borrar nodo con 1 hijo (B, tiene a C)
+		// Árbol debe quedar:
+		//      D
+		//    /   \
+		//   C     F
+		//        / \
+		//       E   G
+		dic.Borrar("B")
+		require.False(t, dic.Pertenece("B"))
+		require.True(t, dic.Pertenece("C"))
+		require.EqualValues(t, 5, dic.Cantidad())
+
+		// Caso 3: Borrar nodo con 2 hijos (D, raíz)
+		// Sucesores 'E'.
+		// Árbol debe quedar:
+		//      E
+		//    /   \
+		//   C     F
+		//          \
+		//           G
+		dic.Borrar("D")
+		require.False(t, dic.Pertenece("D"))
+		require.EqualValues(t, 4, dic.Cantidad())
+		require.True(t, dic.Pertenece("E"))
+		require.True(t, dic.Pertenece("F"))
+		require.True(t, dic.Pertenece("C"))
+		require.True(t, dic.Pertenece("G"))
+	})
 }
 
 func TestABBGuardarYBorrarRepetidasVeces(t *testing.T) {
 	t.Log("Guardar y borrar repetidas veces verifica estabilidad del árbol")
-	dic := TDADiccionario.CrearABB[int, int](cmpInts)
-
-	n := 1000
-	for i := 0; i < n; i++ {
-		dic.Guardar(i, i)
-		require.True(t, dic.Pertenece(i))
-		require.EqualValues(t, i+1, dic.Cantidad())
-	}
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, int](impl, cmpInts)
+
+		n := 1000
+		for i := 0; i < n; i++ {
+			dic.Guardar(i, i)
+			require.True(t, dic.Pertenece(i))
+			require.EqualValues(t, i+1, dic.Cantidad())
+		}
 
-	for i := 0; i < n; i++ {
-		require.EqualValues(t, i, dic.Borrar(i))
-		require.False(t, dic.Pertenece(i))
-		require.EqualValues(t, n-1-i, dic.Cantidad())
-	}
+		for i := 0; i < n; i++ {
+			require.EqualValues(t, i, dic.Borrar(i))
+			require.False(t, dic.Pertenece(i))
+			require.EqualValues(t, n-1-i, dic.Cantidad())
+		}
 
-	require.EqualValues(t, 0, dic.Cantidad())
+		require.EqualValues(t, 0, dic.Cantidad())
+	})
 }
 
 func TestIteradorInternoOrdenado(t *testing.T) {
 	t.Log("El iterador interno recorre elementos en orden")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
-
-	dic.Guardar("D", 4)
-	dic.Guardar("B", 2)
-	dic.Guardar("F", 6)
-	dic.Guardar("A", 1)
-	dic.Guardar("C", 3)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
+
+		dic.Guardar("D", 4)
+		dic.Guardar("B", 2)
+		dic.Guardar("F", 6)
+		dic.Guardar("A", 1)
+		dic.Guardar("C", 3)
+
+		claves := []string{}
+		dic.Iterar(func(clave string, dato int) bool {
+			claves = append(claves, clave)
+			return true
+		})
 
-	claves := []string{}
-	dic.Iterar(func(clave string, dato int) bool {
-		claves = append(claves, clave)
-		return true
+		esperado := []string{"A", "B", "C", "D", "F"}
+		require.EqualValues(t, esperado, claves)
 	})
-
-	esperado := []string{"A", "B", "C", "D", "F"}
-	require.EqualValues(t, esperado, claves)
 }
 
 func TestIteradorInternoCorte(t *testing.T) {
 	t.Log("El iterador interno debe detenerse cuando visitar devuelve false")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
 
-	for i := 0; i < 10; i++ {
-		dic.Guardar(fmt.Sprintf("%d", i), i)
-	}
+		for i := 0; i < 10; i++ {
+			dic.Guardar(fmt.Sprintf("%d", i), i)
+		}
 
-	contador := 0
-	dic.Iterar(func(_ string, _ int) bool {
-		contador++
-		return contador < 5
-	})
+		contador := 0
+		dic.Iterar(func(_ string, _ int) bool {
+			contador++
+			return contador < 5
+		})
 
-	require.EqualValues(t, 5, contador)
+		require.EqualValues(t, 5, contador)
+	})
 }
 
 func TestIterarRangoCompleto(t *testing.T) {
 	t.Log("IterarRango con límites nil debe iterar todo")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
 
-	claves := []string{"D", "B", "F", "A", "C"}
-	for i, k := range claves {
-		dic.Guardar(k, i)
-	}
+		claves := []string{"D", "B", "F", "A", "C"}
+		for i, k := range claves {
+			dic.Guardar(k, i)
+		}
 
-	resultado := []string{}
-	dic.IterarRango(nil, nil, func(clave string, _ int) bool {
-		resultado = append(resultado, clave)
-		return true
-	})
+		resultado := []string{}
+		dic.IterarRango(nil, nil, func(clave string, _ int) bool {
+			resultado = append(resultado, clave)
+			return true
+		})
 
-	esperado := []string{"A", "B", "C", "D", "F"}
-	require.EqualValues(t, esperado, resultado)
+		esperado := []string{"A", "B", "C", "D", "F"}
+		require.EqualValues(t, esperado, resultado)
+	})
 }
 
 func TestIterarRangoSoloDesde(t *testing.T) {
 	t.Log("IterarRango con solo desde definido")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
 
-	claves := []string{"A", "B", "C", "D", "E", "F"}
-	for i, k := range claves {
-		dic.Guardar(k, i)
-	}
+		claves := []string{"A", "B", "C", "D", "E", "F"}
+		for i, k := range claves {
+			dic.Guardar(k, i)
+		}
 
-	desde := "C"
-	resultado := []string{}
-	dic.IterarRango(&desde, nil, func(clave string, _ int) bool {
-		resultado = append(resultado, clave)
-		return true
-	})
+		desde := "C"
+		resultado := []string{}
+		dic.IterarRango(&desde, nil, func(clave string, _ int) bool {
+			resultado = append(resultado, clave)
+			return true
+		})
 
-	esperado := []string{"C", "D", "E", "F"}
-	require.EqualValues(t, esperado, resultado)
+		esperado := []string{"C", "D", "E", "F"}
+		require.EqualValues(t, esperado, resultado)
+	})
 }
 
 func TestIterarRangoSoloHasta(t *testing.T) {
 	t.Log("IterarRango con solo hasta definido")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
 
-	claves := []string{"A", "B", "C", "D", "E", "F"}
-	for i, k := range claves {
-		dic.Guardar(k, i)
-	}
+		claves := []string{"A", "B", "C", "D", "E", "F"}
+		for i, k := range claves {
+			dic.Guardar(k, i)
+		}
 
-	hasta := "D"
-	resultado := []string{}
-	dic.IterarRango(nil, &hasta, func(clave string, _ int) bool {
-		resultado = append(resultado, clave)
-		return true
-	})
+		hasta := "D"
+		resultado := []string{}
+		dic.IterarRango(nil, &hasta, func(clave string, _ int) bool {
+			resultado = append(resultado, clave)
+			return true
+		})
 
-	esperado := []string{"A", "B", "C", "D"}
-	require.EqualValues(t, esperado, resultado)
+		esperado := []string{"A", "B", "C", "D"}
+		require.EqualValues(t, esperado, resultado)
+	})
 }
 
 func TestIteradorExternoTrasBorrados(t *testing.T) {
 	t.Log("Iterador creado tras borrar elementos no los incluye")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
 
-	claves := []string{"A", "B", "C", "D", "E"}
-	for i, k := range claves {
-		dic.Guardar(k, i)
-	}
+		claves := []string{"A", "B", "C", "D", "E"}
+		for i, k := range claves {
+			dic.Guardar(k, i)
+		}
 
-	dic.Borrar("B")
-	dic.Borrar("D")
+		dic.Borrar("B")
+		dic.Borrar("D")
 
-	iter := dic.Iterador()
-	resultado := []string{}
-	for iter.HaySiguiente() {
-		clave, _ := iter.VerActual()
-		resultado = append(resultado, clave)
-		iter.Siguiente()
-	}
+		iter := dic.Iterador()
+		resultado := []string{}
+		for iter.HaySiguiente() {
+			clave, _ := iter.VerActual()
+			resultado = append(resultado, clave)
+			iter.Siguiente()
+		}
 
-	esperado := []string{"A", "C", "E"}
-	require.EqualValues(t, esperado, resultado)
+		esperado := []string{"A", "C", "E"}
+		require.EqualValues(t, esperado, resultado)
+	})
 }
 
 func TestMultiplesIteradores(t *testing.T) {
 	t.Log("Múltiples iteradores sobre el mismo ABB funcionan independientemente")
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
 
-	dic.Guardar("A", 1)
-	dic.Guardar("B", 2)
-	dic.Guardar("C", 3)
+		dic.Guardar("A", 1)
+		dic.Guardar("B", 2)
+		dic.Guardar("C", 3)
 
-	iter1 := dic.Iterador()
-	iter2 := dic.Iterador()
+		iter1 := dic.Iterador()
+		iter2 := dic.Iterador()
 
-	iter1.Siguiente()
-	iter1.Siguiente()
+		iter1.Siguiente()
+		iter1.Siguiente()
 
-	clave, _ := iter2.VerActual()
-	require.EqualValues(t, "A", clave)
-	require.True(t, iter2.HaySiguiente())
+		clave, _ := iter2.VerActual()
+		require.EqualValues(t, "A", clave)
+		require.True(t, iter2.HaySiguiente())
+	})
 }
 
-func ejecutarPruebaVolumenABB(tb testing.TB, n int, ordenado bool) {
-	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
+func TestIteradorABBModificado(t *testing.T) {
+	t.Log("Si se guarda una clave nueva o se borra una mientras hay un iterador en uso, el iterador entra en pánico")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[string, int](impl, cmpStrings)
+		dic.Guardar("B", 2)
+		dic.Guardar("A", 1)
+		dic.Guardar("C", 3)
+
+		iter := dic.IteradorRango(nil, nil)
+		dic.Guardar("A", 10)
+		_, valor := iter.VerActual()
+		require.EqualValues(t, 10, valor)
+
+		dic.Borrar("B")
+		require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+		require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.Siguiente() })
+
+		iter = dic.Iterador()
+		dic.Guardar("D", 4)
+		require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.Siguiente() })
+	})
+}
+
+func ejecutarPruebaVolumenABB(tb testing.TB, impl string, n int, ordenado bool) {
+	dic := crearABB[string, int](impl, cmpStrings)
 
 	claves := make([]string, n)
 	valores := make([]int, n)
@@ -430,18 +508,22 @@ func ejecutarPruebaVolumenABB(tb testing.TB, n int, ordenado bool) {
 
 func TestVolumenABBOrdenado(t *testing.T) {
 	t.Log("Prueba de volumen (peor caso, inserción ordenada)")
-	n := TAMS_VOLUMEN_PRUEBA
-	ejecutarPruebaVolumenABB(t, n, true)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		n := TAMS_VOLUMEN_PRUEBA
+		ejecutarPruebaVolumenABB(t, impl, n, true)
+	})
 }
 
 func TestVolumenABBAleatorio(t *testing.T) {
 	t.Log("Prueba de volumen (caso promedio, inserción aleatoria)")
-	n := TAMS_VOLUMEN_PRUEBA
-	ejecutarPruebaVolumenABB(t, n, false)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		n := TAMS_VOLUMEN_PRUEBA
+		ejecutarPruebaVolumenABB(t, impl, n, false)
+	})
 }
 
-func ejecutarPruebasVolumenIteradorABB(tb testing.TB, n int, ordenado bool) {
-	dic := TDADiccionario.CrearABB[string, *int](cmpStrings)
+func ejecutarPruebasVolumenIteradorABB(tb testing.TB, impl string, n int, ordenado bool) {
+	dic := crearABB[string, *int](impl, cmpStrings)
 
 	claves := make([]string, n)
 	valores := make([]int, n)
@@ -505,44 +587,875 @@ func ejecutarPruebasVolumenIteradorABB(tb testing.TB, n int, ordenado bool) {
 
 func TestVolumenIteradorOrdenado(t *testing.T) {
 	t.Log("Prueba de volumen del iterador (peor caso, inserción ordenada)")
-	n := TAMS_VOLUMEN_PRUEBA
-	ejecutarPruebasVolumenIteradorABB(t, n, true)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		n := TAMS_VOLUMEN_PRUEBA
+		ejecutarPruebasVolumenIteradorABB(t, impl, n, true)
+	})
 }
 
 func TestVolumenIteradorAleatorio(t *testing.T) {
 	t.Log("Prueba de volumen del iterador (caso promedio, inserción aleatoria)")
-	n := TAMS_VOLUMEN_PRUEBA
-	ejecutarPruebasVolumenIteradorABB(t, n, false)
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		n := TAMS_VOLUMEN_PRUEBA
+		ejecutarPruebasVolumenIteradorABB(t, impl, n, false)
+	})
 }
 
 func TestABBVolumenIteradorCorte(t *testing.T) {
 	t.Log("Prueba de volumen de iterador interno, validando que siempre que se indique corte, se corte")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, int](impl, cmpInts)
+		n := 50000
+		for i := 0; i < n; i++ {
+			dic.Guardar(i, i)
+		}
 
-	dic := TDADiccionario.CrearABB[int, int](cmpInts)
-	n := 50000
+		seguirEjecutando := true
+		siguioEjecutandoCuandoNoDebia := false
+		corteEn := n / 10
+		contador := 0
+
+		dic.Iterar(func(c int, v int) bool {
+			if !seguirEjecutando {
+				siguioEjecutandoCuandoNoDebia = true
+				return false
+			}
+			if c == corteEn {
+				seguirEjecutando = false
+				return false
+			}
+			contador++
+			return true
+		})
+
+		require.False(t, seguirEjecutando, "Se tendría que haber encontrado un elemento que genere el corte")
+		require.False(t, siguioEjecutandoCuandoNoDebia, "No debería haber seguido ejecutando después del corte")
+		require.EqualValues(t, corteEn, contador, "El contador no coincide con el punto de corte")
+	})
+}
+
+func TestABBGuardarYBorrarAleatorio(t *testing.T) {
+	t.Log("Guarda y borra claves al azar, comparando contra un map de Go y validando que el iterador del rango " +
+		"devuelva las claves en orden")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, int](impl, cmpInts)
+		esperado := make(map[int]int)
+		aleatorio := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 20000; i++ {
+			clave := aleatorio.Intn(1000)
+			if aleatorio.Intn(3) == 0 {
+				if _, esta := esperado[clave]; esta {
+					require.EqualValues(t, esperado[clave], dic.Borrar(clave))
+					delete(esperado, clave)
+				} else {
+					require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(clave) })
+				}
+			} else {
+				dic.Guardar(clave, i)
+				esperado[clave] = i
+			}
+			require.EqualValues(t, len(esperado), dic.Cantidad())
+		}
+
+		desde, hasta := 250, 750
+		anterior, visitados := -1, 0
+		for iter := dic.IteradorRango(&desde, &hasta); iter.HaySiguiente(); iter.Siguiente() {
+			clave, valor := iter.VerActual()
+			require.Greater(t, clave, anterior)
+			require.EqualValues(t, esperado[clave], valor)
+			anterior = clave
+			visitados++
+		}
+		enRango := 0
+		for clave := range esperado {
+			if clave >= desde && clave <= hasta {
+				enRango++
+			}
+		}
+		require.EqualValues(t, enRango, visitados)
+		require.EqualValues(t, enRango, dic.ContarRango(&desde, &hasta))
+
+		k := 0
+		dic.Iterar(func(clave int, _ int) bool {
+			seleccionada, _ := dic.Seleccionar(k)
+			require.EqualValues(t, clave, seleccionada)
+			k++
+			return true
+		})
+	})
+}
+
+func TestAVLInvariantes(t *testing.T) {
+	t.Log("Después de cada Guardar y Borrar, el AVL sigue siendo un ABB, las alturas guardadas son correctas y " +
+		"los subárboles de cada nodo difieren a lo sumo en uno de altura")
+	dic := TDADiccionario.CrearAVL[int, int](cmpInts)
+	n := 2000
 	for i := 0; i < n; i++ {
 		dic.Guardar(i, i)
+		require.NoError(t, TDADiccionario.VerificarAVL(dic))
+	}
+	for i := 0; i < n; i += 2 {
+		require.EqualValues(t, i, dic.Borrar(i))
+		require.NoError(t, TDADiccionario.VerificarAVL(dic))
+	}
+	for i := 2*n - 1; i >= n; i-- {
+		dic.Guardar(i, i)
+		require.NoError(t, TDADiccionario.VerificarAVL(dic))
 	}
 
-	seguirEjecutando := true
-	siguioEjecutandoCuandoNoDebia := false
-	corteEn := n / 10
-	contador := 0
+	aleatorio := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		clave := aleatorio.Intn(2 * n)
+		if aleatorio.Intn(2) == 0 && dic.Pertenece(clave) {
+			dic.Borrar(clave)
+		} else {
+			dic.Guardar(clave, i)
+		}
+		require.NoError(t, TDADiccionario.VerificarAVL(dic))
+	}
 
-	dic.Iterar(func(c int, v int) bool {
-		if !seguirEjecutando {
-			siguioEjecutandoCuandoNoDebia = true
-			return false
+	for clave := 0; clave < 2*n; clave++ {
+		if dic.Pertenece(clave) {
+			dic.Borrar(clave)
+			require.NoError(t, TDADiccionario.VerificarAVL(dic))
 		}
-		if c == corteEn {
-			seguirEjecutando = false
-			return false
+	}
+	require.EqualValues(t, 0, dic.Cantidad())
+}
+
+func TestRojoNegroInvariantes(t *testing.T) {
+	t.Log("Después de cada Guardar y Borrar, el árbol rojo-negro sigue siendo un ABB, su raíz es negra, ningún " +
+		"nodo rojo tiene hijos rojos y todos los caminos tienen la misma cantidad de nodos negros")
+	dic := TDADiccionario.CrearArbolRojoNegro[int, int](cmpInts)
+	n := 2000
+	for i := 0; i < n; i++ {
+		dic.Guardar(i, i)
+		require.NoError(t, TDADiccionario.VerificarRojoNegro(dic))
+	}
+	for i := 0; i < n; i += 2 {
+		require.EqualValues(t, i, dic.Borrar(i))
+		require.NoError(t, TDADiccionario.VerificarRojoNegro(dic))
+	}
+
+	aleatorio := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		clave := aleatorio.Intn(n)
+		if aleatorio.Intn(2) == 0 && dic.Pertenece(clave) {
+			dic.Borrar(clave)
+		} else {
+			dic.Guardar(clave, i)
+		}
+		require.NoError(t, TDADiccionario.VerificarRojoNegro(dic))
+	}
+
+	for clave := 0; clave < n; clave++ {
+		if dic.Pertenece(clave) {
+			dic.Borrar(clave)
+			require.NoError(t, TDADiccionario.VerificarRojoNegro(dic))
+		}
+	}
+	require.EqualValues(t, 0, dic.Cantidad())
+}
+
+func TestSeleccionarYRango(t *testing.T) {
+	t.Log("Seleccionar, Rango y ContarRango coinciden con las posiciones de las claves en un slice ordenado, " +
+		"también después de borrar")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, int](impl, cmpInts)
+		require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { dic.Seleccionar(0) })
+		require.EqualValues(t, 0, dic.Rango(5))
+		require.EqualValues(t, 0, dic.ContarRango(nil, nil))
+
+		aleatorio := rand.New(rand.NewSource(1))
+		claves := aleatorio.Perm(500)
+		for _, clave := range claves {
+			dic.Guardar(clave*2, clave)
+		}
+		for _, clave := range claves[:200] {
+			dic.Borrar(clave * 2)
+		}
+		ordenadas := make([]int, 0, 300)
+		for clave := 0; clave < 500; clave++ {
+			if dic.Pertenece(clave * 2) {
+				ordenadas = append(ordenadas, clave*2)
+			}
+		}
+		require.Len(t, ordenadas, 300)
+
+		for k, clave := range ordenadas {
+			c, v := dic.Seleccionar(k)
+			require.EqualValues(t, clave, c)
+			require.EqualValues(t, clave/2, v)
+			require.EqualValues(t, k, dic.Rango(clave))
+			// Una clave que no está tiene el mismo rango que la siguiente que sí está
+			require.EqualValues(t, k, dic.Rango(clave-1))
+		}
+		require.EqualValues(t, 300, dic.Rango(1000))
+		require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { dic.Seleccionar(-1) })
+		require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { dic.Seleccionar(300) })
+
+		desde, hasta := ordenadas[10], ordenadas[50]
+		require.EqualValues(t, 41, dic.ContarRango(&desde, &hasta))
+		desdeAfuera, hastaAfuera := ordenadas[10]-1, ordenadas[50]+1
+		require.EqualValues(t, 41, dic.ContarRango(&desdeAfuera, &hastaAfuera))
+		require.EqualValues(t, 290, dic.ContarRango(&desde, nil))
+		require.EqualValues(t, 51, dic.ContarRango(nil, &hasta))
+		require.EqualValues(t, 300, dic.ContarRango(nil, nil))
+		require.EqualValues(t, 0, dic.ContarRango(&hasta, &desde))
+	})
+}
+
+func TestMinimoMaximoYVecinos(t *testing.T) {
+	t.Log("Minimo, Maximo, Piso, Techo, Predecesor y Sucesor encuentran las claves vecinas, pertenezcan o no al " +
+		"diccionario las claves consultadas")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, string](impl, cmpInts)
+		for _, consulta := range []func() (int, string, bool){
+			dic.Minimo,
+			dic.Maximo,
+			func() (int, string, bool) { return dic.Piso(5) },
+			func() (int, string, bool) { return dic.Techo(5) },
+			func() (int, string, bool) { return dic.Predecesor(5) },
+			func() (int, string, bool) { return dic.Sucesor(5) },
+		} {
+			clave, dato, ok := consulta()
+			require.False(t, ok)
+			require.EqualValues(t, 0, clave)
+			require.EqualValues(t, "", dato)
+		}
+
+		for _, clave := range []int{50, 20, 80, 10, 30, 70, 90} {
+			dic.Guardar(clave, fmt.Sprintf("v%d", clave))
+		}
+		verificar := func(claveEsperada int, okEsperado bool, clave int, dato string, ok bool) {
+			require.Equal(t, okEsperado, ok)
+			if okEsperado {
+				require.EqualValues(t, claveEsperada, clave)
+				require.EqualValues(t, fmt.Sprintf("v%d", claveEsperada), dato)
+			}
+		}
+
+		clave, dato, ok := dic.Minimo()
+		verificar(10, true, clave, dato, ok)
+		clave, dato, ok = dic.Maximo()
+		verificar(90, true, clave, dato, ok)
+
+		// Claves que pertenecen
+		clave, dato, ok = dic.Piso(30)
+		verificar(30, true, clave, dato, ok)
+		clave, dato, ok = dic.Techo(30)
+		verificar(30, true, clave, dato, ok)
+		clave, dato, ok = dic.Predecesor(30)
+		verificar(20, true, clave, dato, ok)
+		clave, dato, ok = dic.Sucesor(30)
+		verificar(50, true, clave, dato, ok)
+		clave, dato, ok = dic.Predecesor(50)
+		verificar(30, true, clave, dato, ok)
+		clave, dato, ok = dic.Sucesor(50)
+		verificar(70, true, clave, dato, ok)
+
+		// Claves que no pertenecen
+		clave, dato, ok = dic.Piso(65)
+		verificar(50, true, clave, dato, ok)
+		clave, dato, ok = dic.Techo(65)
+		verificar(70, true, clave, dato, ok)
+		clave, dato, ok = dic.Predecesor(65)
+		verificar(50, true, clave, dato, ok)
+		clave, dato, ok = dic.Sucesor(65)
+		verificar(70, true, clave, dato, ok)
+
+		// Extremos
+		clave, dato, ok = dic.Piso(5)
+		verificar(0, false, clave, dato, ok)
+		clave, dato, ok = dic.Predecesor(10)
+		verificar(0, false, clave, dato, ok)
+		clave, dato, ok = dic.Techo(95)
+		verificar(0, false, clave, dato, ok)
+		clave, dato, ok = dic.Sucesor(90)
+		verificar(0, false, clave, dato, ok)
+		clave, dato, ok = dic.Piso(1000)
+		verificar(90, true, clave, dato, ok)
+		clave, dato, ok = dic.Techo(-1000)
+		verificar(10, true, clave, dato, ok)
+	})
+}
+
+func TestIteracionInversa(t *testing.T) {
+	t.Log("Los iteradores inversos recorren las claves de mayor a menor, respetando los extremos del rango y el " +
+		"corte del iterador interno")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, int](impl, cmpInts)
+		vacio := dic.IteradorRangoInverso(nil, nil)
+		require.False(t, vacio.HaySiguiente())
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { vacio.VerActual() })
+
+		for _, clave := range rand.New(rand.NewSource(1)).Perm(100) {
+			dic.Guardar(clave, -clave)
+		}
+		esperadas := func(desde, hasta int) []int {
+			claves := []int{}
+			for clave := hasta; clave >= desde; clave-- {
+				claves = append(claves, clave)
+			}
+			return claves
+		}
+		recorrerInterno := func(desde, hasta *int) []int {
+			claves := []int{}
+			dic.IterarRangoInverso(desde, hasta, func(clave int, dato int) bool {
+				require.EqualValues(t, -clave, dato)
+				claves = append(claves, clave)
+				return true
+			})
+			return claves
+		}
+		recorrerExterno := func(desde, hasta *int) []int {
+			claves := []int{}
+			for iter := dic.IteradorRangoInverso(desde, hasta); iter.HaySiguiente(); iter.Siguiente() {
+				clave, dato := iter.VerActual()
+				require.EqualValues(t, -clave, dato)
+				claves = append(claves, clave)
+			}
+			return claves
+		}
+
+		desde, hasta := 20, 75
+		antesDelPrincipio, despuesDelFinal := -5, 150
+		require.Equal(t, esperadas(0, 99), recorrerInterno(nil, nil))
+		require.Equal(t, esperadas(0, 99), recorrerExterno(nil, nil))
+		require.Equal(t, esperadas(20, 75), recorrerInterno(&desde, &hasta))
+		require.Equal(t, esperadas(20, 75), recorrerExterno(&desde, &hasta))
+		require.Equal(t, esperadas(20, 99), recorrerExterno(&desde, nil))
+		require.Equal(t, esperadas(0, 75), recorrerExterno(nil, &hasta))
+		require.Equal(t, esperadas(0, 99), recorrerExterno(&antesDelPrincipio, &despuesDelFinal))
+		require.Empty(t, recorrerExterno(&hasta, &desde))
+		require.Empty(t, recorrerInterno(&despuesDelFinal, nil))
+
+		claves := []int{}
+		dic.IterarInverso(func(clave int, _ int) bool {
+			claves = append(claves, clave)
+			return clave > 90
+		})
+		require.Equal(t, esperadas(90, 99), claves)
+	})
+}
+
+func TestCrearABBDesdeOrdenados(t *testing.T) {
+	t.Log("Un ABB creado desde claves ordenadas tiene todas las claves, y se puede seguir usando normalmente")
+	n := 100000
+	claves := make([]int, n)
+	datos := make([]string, n)
+	for i := range claves {
+		claves[i] = i * 3
+		datos[i] = fmt.Sprintf("%d", i)
+	}
+	dic := TDADiccionario.CrearABBDesdeOrdenados(claves, datos, cmpInts)
+	require.EqualValues(t, n, dic.Cantidad())
+	for i := 0; i < n; i += 997 {
+		require.EqualValues(t, datos[i], dic.Obtener(claves[i]))
+		clave, _ := dic.Seleccionar(i)
+		require.EqualValues(t, claves[i], clave)
+	}
+	i := 0
+	dic.Iterar(func(clave int, dato string) bool {
+		require.EqualValues(t, claves[i], clave)
+		i++
+		return true
+	})
+	require.EqualValues(t, n, i)
+
+	dic.Guardar(1, "nuevo")
+	require.EqualValues(t, 2, dic.Rango(3))
+	require.EqualValues(t, "0", dic.Borrar(0))
+	require.EqualValues(t, n, dic.Cantidad())
+
+	vacio := TDADiccionario.CrearABBDesdeOrdenados([]int{}, []int{}, cmpInts)
+	require.EqualValues(t, 0, vacio.Cantidad())
+	require.False(t, vacio.Iterador().HaySiguiente())
+
+	require.PanicsWithValue(t, "Las claves y los datos tienen distinto largo", func() {
+		TDADiccionario.CrearABBDesdeOrdenados([]int{1, 2}, []int{1}, cmpInts)
+	})
+	require.PanicsWithValue(t, "Las claves no estan ordenadas", func() {
+		TDADiccionario.CrearABBDesdeOrdenados([]int{1, 3, 2}, []int{1, 2, 3}, cmpInts)
+	})
+	require.PanicsWithValue(t, "Las claves no estan ordenadas", func() {
+		TDADiccionario.CrearABBDesdeOrdenados([]int{1, 2, 2}, []int{1, 2, 3}, cmpInts)
+	})
+}
+
+func TestCrearABBDesdeIterador(t *testing.T) {
+	t.Log("Se puede crear un ABB balanceado a partir del iterador de otro diccionario ordenado")
+	original := TDADiccionario.CrearABB[string, int](cmpStrings)
+	for i := 0; i < 1000; i++ {
+		original.Guardar(fmt.Sprintf("%04d", i), i)
+	}
+	desde, hasta := "0100", "0199"
+	copia := TDADiccionario.CrearABBDesdeIterador(original.IteradorRango(&desde, &hasta), cmpStrings)
+	require.EqualValues(t, 100, copia.Cantidad())
+	require.EqualValues(t, 150, copia.Obtener("0150"))
+	require.False(t, copia.Pertenece("0200"))
+
+	require.PanicsWithValue(t, "Las claves no estan ordenadas", func() {
+		TDADiccionario.CrearABBDesdeIterador(original.IteradorRangoInverso(nil, nil), cmpStrings)
+	})
+}
+
+func TestRebalancear(t *testing.T) {
+	t.Log("Rebalancear conserva las claves, los datos y las estadísticas de orden de cualquier implementación")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		// Todos los tamaños chicos, para pasar por todas las formas de repartir los nodos
+		tams := []int{100, 1000, 2186}
+		for n := 0; n <= 80; n++ {
+			tams = append(tams, n)
+		}
+		for _, n := range tams {
+			dic := crearABB[int, int](impl, cmpInts)
+			for i := 0; i < n; i++ {
+				dic.Guardar(i, -i)
+			}
+			iter := dic.Iterador()
+			dic.Rebalancear()
+			require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+
+			require.EqualValues(t, n, dic.Cantidad())
+			for i := 0; i < n; i++ {
+				require.EqualValues(t, -i, dic.Obtener(i))
+				clave, _ := dic.Seleccionar(i)
+				require.EqualValues(t, i, clave)
+			}
+			if impl == "Rojo-negro" {
+				require.NoError(t, TDADiccionario.VerificarRojoNegro(dic))
+			}
+
+			// Sigue funcionando después de rebalancear
+			dic.Guardar(n, -n)
+			if n > 0 {
+				dic.Borrar(0)
+			}
+			if impl == "Rojo-negro" {
+				require.NoError(t, TDADiccionario.VerificarRojoNegro(dic))
+			}
+		}
+	})
+}
+
+func TestVolumenABBDegenerado(t *testing.T) {
+	t.Log("Las búsquedas y los recorridos funcionan sobre un ABB degenerado de un millón de nodos, sin depender " +
+		"de la altura del árbol para la pila de llamados")
+	n := 1000000
+	dic := TDADiccionario.CrearABBDegenerado(n)
+	require.EqualValues(t, n, dic.Cantidad())
+
+	require.True(t, dic.Pertenece(n-1))
+	require.EqualValues(t, n-1, dic.Obtener(n-1))
+	require.False(t, dic.Pertenece(n))
+	require.EqualValues(t, n-1, dic.Rango(n-1))
+	clave, _, ok := dic.Piso(n + 10)
+	require.True(t, ok)
+	require.EqualValues(t, n-1, clave)
+
+	visitados, ok := 0, true
+	dic.Iterar(func(clave int, dato int) bool {
+		ok = ok && clave == visitados
+		visitados++
+		return true
+	})
+	require.True(t, ok, "El recorrido no fue en orden")
+	require.EqualValues(t, n, visitados)
+
+	desde := n - 10
+	claves := []int{}
+	dic.IterarRango(&desde, nil, func(clave int, _ int) bool {
+		claves = append(claves, clave)
+		return true
+	})
+	require.Len(t, claves, 10)
+	require.EqualValues(t, n-10, claves[0])
+
+	visitados = 0
+	dic.IterarInverso(func(clave int, _ int) bool {
+		ok = ok && clave == n-1-visitados
+		visitados++
+		return true
+	})
+	require.True(t, ok, "El recorrido inverso no fue en orden")
+	require.EqualValues(t, n, visitados)
+
+	iter := dic.IteradorRango(&desde, nil)
+	clave, _ = iter.VerActual()
+	require.EqualValues(t, n-10, clave)
+
+	dic.Guardar(n, n)
+	require.EqualValues(t, n, dic.Obtener(n))
+	require.EqualValues(t, n-1, dic.Borrar(n-1))
+	require.False(t, dic.Pertenece(n-1))
+	require.EqualValues(t, n, dic.Cantidad())
+
+	dic.Rebalancear()
+	require.EqualValues(t, n, dic.Cantidad())
+	require.EqualValues(t, n-2, dic.Obtener(n-2))
+	seleccionada, _ := dic.Seleccionar(n - 1)
+	require.EqualValues(t, n, seleccionada)
+}
+
+func TestAlturaYBalance(t *testing.T) {
+	t.Log("La altura y el balance reflejan la forma del árbol: un ABB con claves en orden queda degenerado, los " +
+		"árboles autobalanceados no, y después de rebalancear todos tienen la altura mínima")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, int](impl, cmpInts)
+		forma := dic.(TDADiccionario.ConFormaABB[int])
+		require.EqualValues(t, 0, forma.Altura())
+		require.Empty(t, forma.Balance().Subarboles)
+
+		dic.Guardar(0, 0)
+		balance := forma.Balance()
+		require.EqualValues(t, 1, balance.Altura)
+		require.EqualValues(t, 0, balance.ProfundidadMinimaHoja)
+		require.EqualValues(t, 0, balance.ProfundidadMaximaHoja)
+
+		n := 1023
+		for i := 1; i < n; i++ {
+			dic.Guardar(i, i)
+		}
+		altura := forma.Altura()
+		switch impl {
+		case "ABB":
+			require.EqualValues(t, n, altura)
+		case "AVL":
+			require.LessOrEqual(t, float64(altura), 1.45*math.Log2(float64(n+2)))
+		case "Rojo-negro":
+			require.LessOrEqual(t, float64(altura), 2*math.Log2(float64(n+1)))
 		}
-		contador++
+
+		balance = forma.Balance()
+		require.EqualValues(t, altura, balance.Altura)
+		require.EqualValues(t, altura-1, balance.ProfundidadMaximaHoja)
+		require.LessOrEqual(t, balance.ProfundidadMinimaHoja, balance.ProfundidadMaximaHoja)
+		require.Len(t, balance.Subarboles, n)
+		for i, subarbol := range balance.Subarboles {
+			require.EqualValues(t, i, subarbol.Clave)
+			require.EqualValues(t, subarbol.TamanioIzquierdo+subarbol.TamanioDerecho+1, subarbol.Tamanio)
+			if subarbol.Profundidad == 0 {
+				require.EqualValues(t, n, subarbol.Tamanio)
+			}
+		}
+
+		dic.Rebalancear()
+		balance = forma.Balance()
+		require.EqualValues(t, 10, balance.Altura)
+		require.EqualValues(t, 9, balance.ProfundidadMinimaHoja)
+		require.EqualValues(t, 9, balance.ProfundidadMaximaHoja)
+	})
+}
+
+func TestExportarDOT(t *testing.T) {
+	t.Log("El árbol se exporta en formato DOT, con las claves formateadas y escapadas, y los hijos que faltan " +
+		"dibujados como puntos")
+	dic := TDADiccionario.CrearABB[string, int](cmpStrings)
+	dic.Guardar("b", 1)
+	dic.Guardar("a", 2)
+	dic.Guardar(`"c"`, 3)
+
+	var salida strings.Builder
+	err := dic.(TDADiccionario.ConFormaABB[string]).ExportarDOT(&salida, strings.ToUpper)
+	require.NoError(t, err)
+	require.Equal(t, `digraph ABB {
+	node [shape=circle];
+	n0 [label="B"];
+	n1 [label="A"];
+	n2 [label="\"C\""];
+	n0 -> n1;
+	vacio0 [shape=point];
+	n0 -> vacio0;
+	n1 -> n2;
+	vacio1 [shape=point];
+	n1 -> vacio1;
+}
+`, salida.String())
+
+	rojoNegro := TDADiccionario.CrearArbolRojoNegro[int, int](cmpInts)
+	rojoNegro.Guardar(1, 1)
+	rojoNegro.Guardar(2, 2)
+	salida.Reset()
+	err = rojoNegro.(TDADiccionario.ConFormaABB[int]).ExportarDOT(&salida, func(clave int) string {
+		return fmt.Sprintf("%d", clave)
+	})
+	require.NoError(t, err)
+	require.Contains(t, salida.String(), `[label="1", color=red, fontcolor=red]`)
+	require.Contains(t, salida.String(), `[label="2"]`)
+}
+
+// recorrerInterno y recorrerExterno devuelven las claves en el orden en que las visita cada iterador
+func recorrerInterno(iterar func(func(int, int) bool)) []int {
+	claves := []int{}
+	iterar(func(clave int, _ int) bool {
+		claves = append(claves, clave)
 		return true
 	})
+	return claves
+}
+
+func recorrerExterno(iter TDADiccionario.IterDiccionario[int, int]) []int {
+	claves := []int{}
+	for ; iter.HaySiguiente(); iter.Siguiente() {
+		clave, _ := iter.VerActual()
+		claves = append(claves, clave)
+	}
+	return claves
+}
+
+func TestRecorridosPreorderPostorderYPorNiveles(t *testing.T) {
+	t.Log("Los recorridos preorder, postorder y por niveles visitan los nodos en el orden que corresponde a la " +
+		"forma del árbol, tanto con el iterador interno como con el externo")
+	dic := TDADiccionario.CrearABB[int, int](cmpInts)
+	recorridos := dic.(TDADiccionario.ConRecorridos[int, int])
+	require.Empty(t, recorrerInterno(recorridos.IterarPreorder))
+	require.Empty(t, recorrerExterno(recorridos.IteradorPostorder()))
+	require.Empty(t, recorrerExterno(recorridos.IteradorPorNiveles()))
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { recorridos.IteradorPreorder().Siguiente() })
+
+	//        4
+	//      /   \
+	//     2     6
+	//    / \   / \
+	//   1   3 5   7
+	for _, clave := range []int{4, 2, 6, 1, 3, 7, 5} {
+		dic.Guardar(clave, clave)
+	}
+	preorder := []int{4, 2, 1, 3, 6, 5, 7}
+	postorder := []int{1, 3, 2, 5, 7, 6, 4}
+	porNiveles := []int{4, 2, 6, 1, 3, 5, 7}
+	require.Equal(t, preorder, recorrerInterno(recorridos.IterarPreorder))
+	require.Equal(t, preorder, recorrerExterno(recorridos.IteradorPreorder()))
+	require.Equal(t, postorder, recorrerInterno(recorridos.IterarPostorder))
+	require.Equal(t, postorder, recorrerExterno(recorridos.IteradorPostorder()))
+	require.Equal(t, porNiveles, recorrerInterno(recorridos.IterarPorNiveles))
+	require.Equal(t, porNiveles, recorrerExterno(recorridos.IteradorPorNiveles()))
+
+	// Corte del iterador interno
+	visitados := []int{}
+	recorridos.IterarPostorder(func(clave int, _ int) bool {
+		visitados = append(visitados, clave)
+		return clave != 2
+	})
+	require.Equal(t, []int{1, 3, 2}, visitados)
+
+	// Un árbol degenerado hacia la derecha
+	degenerado := TDADiccionario.CrearABB[int, int](cmpInts)
+	for i := 0; i < 5; i++ {
+		degenerado.Guardar(i, i)
+	}
+	recorridosDegenerado := degenerado.(TDADiccionario.ConRecorridos[int, int])
+	require.Equal(t, []int{4, 3, 2, 1, 0}, recorrerExterno(recorridosDegenerado.IteradorPostorder()))
+	require.Equal(t, []int{0, 1, 2, 3, 4}, recorrerExterno(recorridosDegenerado.IteradorPorNiveles()))
+
+	iter := recorridos.IteradorPorNiveles()
+	dic.Borrar(4)
+	require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+}
+
+func TestCrearABBDesdePreorder(t *testing.T) {
+	t.Log("Un ABB reconstruido desde su preorder tiene la misma forma que el original")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		original := crearABB[int, int](impl, cmpInts)
+		for _, clave := range rand.New(rand.NewSource(1)).Perm(2000) {
+			original.Guardar(clave, -clave)
+		}
+		recorridos := original.(TDADiccionario.ConRecorridos[int, int])
+		var claves, datos []int
+		recorridos.IterarPreorder(func(clave int, dato int) bool {
+			claves = append(claves, clave)
+			datos = append(datos, dato)
+			return true
+		})
+
+		copia := TDADiccionario.CrearABBDesdePreorder(claves, datos, cmpInts)
+		recorridosCopia := copia.(TDADiccionario.ConRecorridos[int, int])
+		require.EqualValues(t, original.Cantidad(), copia.Cantidad())
+		require.Equal(t, claves, recorrerInterno(recorridosCopia.IterarPreorder))
+		require.Equal(t, recorrerInterno(recorridos.IterarPostorder), recorrerInterno(recorridosCopia.IterarPostorder))
+		require.Equal(t, recorrerInterno(original.Iterar), recorrerInterno(copia.Iterar))
+		require.EqualValues(t, -100, copia.Obtener(100))
+		clave, _ := copia.Seleccionar(1000)
+		require.EqualValues(t, 1000, clave)
+	})
+
+	require.EqualValues(t, 0, TDADiccionario.CrearABBDesdePreorder([]int{}, []int{}, cmpInts).Cantidad())
+	require.PanicsWithValue(t, "Las claves y los datos tienen distinto largo", func() {
+		TDADiccionario.CrearABBDesdePreorder([]int{1}, []int{}, cmpInts)
+	})
+	for _, invalida := range [][]int{{2, 3, 1}, {4, 2, 5, 3}, {2, 2}, {3, 1, 1}, {1, 3, 2, 3}} {
+		require.PanicsWithValue(t, "La secuencia no es el preorder de un ABB", func() {
+			TDADiccionario.CrearABBDesdePreorder(invalida, make([]int, len(invalida)), cmpInts)
+		}, "%v", invalida)
+	}
+}
+
+// verificarArbol valida los invariantes propios de la implementación, si los tiene
+func verificarArbol(t *testing.T, impl string, dic TDADiccionario.DiccionarioOrdenado[int, int]) {
+	switch impl {
+	case "AVL":
+		require.NoError(t, TDADiccionario.VerificarAVL(dic))
+	case "Rojo-negro":
+		require.NoError(t, TDADiccionario.VerificarRojoNegro(dic))
+	}
+}
+
+// verificarClaves valida que el diccionario tenga exactamente las claves de desde a hasta-1, con sus datos y
+// sus posiciones
+func verificarClaves(t *testing.T, dic TDADiccionario.DiccionarioOrdenado[int, int], desde, hasta int) {
+	require.EqualValues(t, max(hasta-desde, 0), dic.Cantidad())
+	for i := desde; i < hasta; i++ {
+		require.EqualValues(t, -i, dic.Obtener(i))
+		clave, _ := dic.Seleccionar(i - desde)
+		require.EqualValues(t, i, clave)
+	}
+	require.False(t, dic.Pertenece(desde-1))
+	require.False(t, dic.Pertenece(hasta))
+}
+
+// crearConRango crea un diccionario con las claves de desde a hasta-1, guardadas en un orden aleatorio
+func crearConRango(impl string, desde, hasta int, semilla int64) TDADiccionario.DiccionarioOrdenado[int, int] {
+	dic := crearABB[int, int](impl, cmpInts)
+	for _, i := range rand.New(rand.NewSource(semilla)).Perm(max(hasta-desde, 0)) {
+		dic.Guardar(desde+i, -(desde + i))
+	}
+	return dic
+}
+
+func TestDividir(t *testing.T) {
+	t.Log("Dividir reparte las claves entre las menores y las mayores o iguales, dejando vacío al original")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		for _, n := range []int{0, 1, 2, 3, 10, 100, 1000} {
+			for _, corte := range []int{-1, 0, 1, n / 3, n / 2, n - 1, n, n + 5} {
+				dic := crearConRango(impl, 0, n, int64(n))
+				iter := dic.Iterador()
+				menores, mayores := dic.Dividir(corte)
+				require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+				require.EqualValues(t, 0, dic.Cantidad())
+				require.False(t, dic.Iterador().HaySiguiente())
+
+				corte := min(max(corte, 0), n)
+				verificarClaves(t, menores, 0, corte)
+				verificarClaves(t, mayores, corte, n)
+				verificarArbol(t, impl, menores)
+				verificarArbol(t, impl, mayores)
+
+				// Los resultados son diccionarios como cualquier otro
+				menores.Guardar(-1, 1)
+				mayores.Guardar(n, -n)
+				if corte > 0 {
+					menores.Borrar(0)
+				}
+				verificarArbol(t, impl, menores)
+				verificarArbol(t, impl, mayores)
+				dic.Guardar(7, 7)
+				require.EqualValues(t, 7, dic.Obtener(7))
+			}
+		}
+	})
+}
+
+func TestDividirClaveInexistente(t *testing.T) {
+	t.Log("La clave por la que se divide no necesita pertenecer al diccionario")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearABB[int, int](impl, cmpInts)
+		for i := 0; i < 100; i++ {
+			dic.Guardar(2*i, i)
+		}
+		menores, mayores := dic.Dividir(51)
+		require.EqualValues(t, 26, menores.Cantidad())
+		require.EqualValues(t, 74, mayores.Cantidad())
+		maximo, _, _ := menores.Maximo()
+		minimo, _, _ := mayores.Minimo()
+		require.EqualValues(t, 50, maximo)
+		require.EqualValues(t, 52, minimo)
+	})
+}
+
+func TestUnir(t *testing.T) {
+	t.Log("Unir junta dos diccionarios de claves disjuntas, sin importar cuál tiene las menores ni sus tamaños")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		for _, tams := range [][2]int{{0, 0}, {0, 5}, {5, 0}, {1, 1}, {1, 1000}, {1000, 1}, {37, 500}, {500, 500}} {
+			for _, invertido := range []bool{false, true} {
+				menor := crearConRango(impl, 0, tams[0], 1)
+				mayor := crearConRango(impl, tams[0], tams[0]+tams[1], 2)
+				dic, otro := menor, mayor
+				if invertido {
+					dic, otro = mayor, menor
+				}
+				iter := otro.Iterador()
+				dic.Unir(otro)
+				require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+				require.EqualValues(t, 0, otro.Cantidad())
+				require.False(t, otro.Iterador().HaySiguiente())
+
+				verificarClaves(t, dic, 0, tams[0]+tams[1])
+				verificarArbol(t, impl, dic)
+				dic.Guardar(-1, 1)
+				dic.Borrar(-1)
+				verificarArbol(t, impl, dic)
+			}
+		}
+	})
+}
+
+func TestUnirInvalido(t *testing.T) {
+	t.Log("Unir entra en pánico si las claves se superponen, consigo mismo o con otra implementación")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		dic := crearConRango(impl, 0, 10, 1)
+		for _, rango := range [][2]int{{9, 20}, {-5, 1}, {3, 4}, {-5, 20}} {
+			otro := crearConRango(impl, rango[0], rango[1], 2)
+			require.PanicsWithValue(t, "Las claves de los diccionarios se superponen", func() { dic.Unir(otro) })
+			require.EqualValues(t, rango[1]-rango[0], otro.Cantidad())
+		}
+		require.PanicsWithValue(t, "No se puede unir un diccionario consigo mismo", func() { dic.Unir(dic) })
+		for _, otraImpl := range IMPLEMENTACIONES_ABB {
+			if otraImpl != impl {
+				otro := crearConRango(otraImpl, 20, 30, 2)
+				require.PanicsWithValue(t, "Los diccionarios no son de la misma implementacion", func() { dic.Unir(otro) })
+			}
+		}
+		verificarClaves(t, dic, 0, 10)
+	})
+}
+
+func TestDividirYUnirAleatorio(t *testing.T) {
+	t.Log("Dividir y volver a unir en cualquier orden conserva las claves y los invariantes")
+	paraCadaABB(t, func(t *testing.T, impl string) {
+		r := rand.New(rand.NewSource(5))
+		n := 2000
+		dic := crearConRango(impl, 0, n, 3)
+		for i := 0; i < 200; i++ {
+			menores, mayores := dic.Dividir(r.Intn(n+2) - 1)
+			verificarArbol(t, impl, menores)
+			verificarArbol(t, impl, mayores)
+			if r.Intn(2) == 0 {
+				menores.Unir(mayores)
+				dic = menores
+			} else {
+				mayores.Unir(menores)
+				dic = mayores
+			}
+			verificarArbol(t, impl, dic)
+			require.EqualValues(t, n, dic.Cantidad())
+		}
+		verificarClaves(t, dic, 0, n)
+	})
+}
 
-	require.False(t, seguirEjecutando, "Se tendría que haber encontrado un elemento que genere el corte")
-	require.False(t, siguioEjecutandoCuandoNoDebia, "No debería haber seguido ejecutando después del corte")
-	require.EqualValues(t, corteEn, contador, "El contador no coincide con el punto de corte")
+func TestDividirABBDegenerado(t *testing.T) {
+	t.Log("Dividir y Unir funcionan en un ABB tan alto como su cantidad de nodos")
+	n := 100000
+	dic := TDADiccionario.CrearABBDegenerado(n)
+	menores, mayores := dic.Dividir(n / 2)
+	require.EqualValues(t, n/2, menores.Cantidad())
+	require.EqualValues(t, n-n/2, mayores.Cantidad())
+	clave, _ := mayores.Seleccionar(0)
+	require.EqualValues(t, n/2, clave)
+	menores.Unir(mayores)
+	require.EqualValues(t, n, menores.Cantidad())
+	clave, _ = menores.Seleccionar(n - 1)
+	require.EqualValues(t, n-1, clave)
 }
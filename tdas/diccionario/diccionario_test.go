@@ -2,8 +2,10 @@ package diccionario_test
 
 import (
 	"fmt"
+	"math/rand"
 	TDADiccionario "tdas/diccionario"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -18,287 +20,949 @@ func igualdadInts(a, b int) bool {
 	return a == b
 }
 
+var IMPLEMENTACIONES_HASH = []string{"Cerrado", "Cerrado incremental", "Robin Hood", "Abierto"}
+
+// crearHash crea un diccionario vacío de la implementación indicada, para correr las mismas pruebas sobre todas
+func crearHash[K any, V any](impl string, igualdad func(K, K) bool) TDADiccionario.Diccionario[K, V] {
+	switch impl {
+	case "Cerrado incremental":
+		return TDADiccionario.CrearHashConOpciones[K, V](igualdad, TDADiccionario.OpcionesHash[K]{
+			RedimensionIncremental: true,
+		})
+	case "Robin Hood":
+		return TDADiccionario.CrearHashRobinHood[K, V](igualdad)
+	case "Abierto":
+		return TDADiccionario.CrearHashAbierto[K, V](igualdad)
+	}
+	return TDADiccionario.CrearHash[K, V](igualdad)
+}
+
+func paraCadaHash(t *testing.T, prueba func(t *testing.T, impl string)) {
+	for _, impl := range IMPLEMENTACIONES_HASH {
+		t.Run(impl, func(t *testing.T) {
+			prueba(t, impl)
+		})
+	}
+}
+
 func TestDiccionarioVacio(t *testing.T) {
 	t.Log("Comprueba que Diccionario vacio no tiene claves")
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	require.EqualValues(t, 0, dic.Cantidad())
-	require.False(t, dic.Pertenece("A"))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, string](impl, igualdadStrings)
+		require.EqualValues(t, 0, dic.Cantidad())
+		require.False(t, dic.Pertenece("A"))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	})
 }
 
 func TestDiccionarioClaveDefault(t *testing.T) {
 	t.Log("Prueba sobre un Hash vacío que si justo buscamos la clave que es el default del tipo de dato, " +
 		"sigue sin existir")
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	require.False(t, dic.Pertenece(""))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("") })
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("") })
-
-	dicNum := TDADiccionario.CrearHash[int, string](igualdadInts)
-	require.False(t, dicNum.Pertenece(0))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dicNum.Obtener(0) })
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dicNum.Borrar(0) })
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, string](impl, igualdadStrings)
+		require.False(t, dic.Pertenece(""))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("") })
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("") })
+
+		dicNum := crearHash[int, string](impl, igualdadInts)
+		require.False(t, dicNum.Pertenece(0))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dicNum.Obtener(0) })
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dicNum.Borrar(0) })
+	})
 }
 
 func TestUnElement(t *testing.T) {
 	t.Log("Comprueba que Diccionario con un elemento tiene esa Clave, unicamente")
-	dic := TDADiccionario.CrearHash[string, int](igualdadStrings)
-	dic.Guardar("A", 10)
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.True(t, dic.Pertenece("A"))
-	require.False(t, dic.Pertenece("B"))
-	require.EqualValues(t, 10, dic.Obtener("A"))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("B") })
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, int](impl, igualdadStrings)
+		dic.Guardar("A", 10)
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.True(t, dic.Pertenece("A"))
+		require.False(t, dic.Pertenece("B"))
+		require.EqualValues(t, 10, dic.Obtener("A"))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("B") })
+	})
 }
 
 func TestDiccionarioGuardar(t *testing.T) {
 	t.Log("Guarda algunos pocos elementos en el diccionario, y se comprueba que en todo momento funciona acorde")
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	valor1 := "miau"
-	valor2 := "guau"
-	valor3 := "moo"
-	claves := []string{clave1, clave2, clave3}
-	valores := []string{valor1, valor2, valor3}
-
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	require.False(t, dic.Pertenece(claves[0]))
-	require.False(t, dic.Pertenece(claves[0]))
-	dic.Guardar(claves[0], valores[0])
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.True(t, dic.Pertenece(claves[0]))
-	require.True(t, dic.Pertenece(claves[0]))
-	require.EqualValues(t, valores[0], dic.Obtener(claves[0]))
-	require.EqualValues(t, valores[0], dic.Obtener(claves[0]))
-
-	require.False(t, dic.Pertenece(claves[1]))
-	require.False(t, dic.Pertenece(claves[2]))
-	dic.Guardar(claves[1], valores[1])
-	require.True(t, dic.Pertenece(claves[0]))
-	require.True(t, dic.Pertenece(claves[1]))
-	require.EqualValues(t, 2, dic.Cantidad())
-	require.EqualValues(t, valores[0], dic.Obtener(claves[0]))
-	require.EqualValues(t, valores[1], dic.Obtener(claves[1]))
-
-	require.False(t, dic.Pertenece(claves[2]))
-	dic.Guardar(claves[2], valores[2])
-	require.True(t, dic.Pertenece(claves[0]))
-	require.True(t, dic.Pertenece(claves[1]))
-	require.True(t, dic.Pertenece(claves[2]))
-	require.EqualValues(t, 3, dic.Cantidad())
-	require.EqualValues(t, valores[0], dic.Obtener(claves[0]))
-	require.EqualValues(t, valores[1], dic.Obtener(claves[1]))
-	require.EqualValues(t, valores[2], dic.Obtener(claves[2]))
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		clave1 := "Gato"
+		clave2 := "Perro"
+		clave3 := "Vaca"
+		valor1 := "miau"
+		valor2 := "guau"
+		valor3 := "moo"
+		claves := []string{clave1, clave2, clave3}
+		valores := []string{valor1, valor2, valor3}
+
+		dic := crearHash[string, string](impl, igualdadStrings)
+		require.False(t, dic.Pertenece(claves[0]))
+		require.False(t, dic.Pertenece(claves[0]))
+		dic.Guardar(claves[0], valores[0])
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.True(t, dic.Pertenece(claves[0]))
+		require.True(t, dic.Pertenece(claves[0]))
+		require.EqualValues(t, valores[0], dic.Obtener(claves[0]))
+		require.EqualValues(t, valores[0], dic.Obtener(claves[0]))
+
+		require.False(t, dic.Pertenece(claves[1]))
+		require.False(t, dic.Pertenece(claves[2]))
+		dic.Guardar(claves[1], valores[1])
+		require.True(t, dic.Pertenece(claves[0]))
+		require.True(t, dic.Pertenece(claves[1]))
+		require.EqualValues(t, 2, dic.Cantidad())
+		require.EqualValues(t, valores[0], dic.Obtener(claves[0]))
+		require.EqualValues(t, valores[1], dic.Obtener(claves[1]))
+
+		require.False(t, dic.Pertenece(claves[2]))
+		dic.Guardar(claves[2], valores[2])
+		require.True(t, dic.Pertenece(claves[0]))
+		require.True(t, dic.Pertenece(claves[1]))
+		require.True(t, dic.Pertenece(claves[2]))
+		require.EqualValues(t, 3, dic.Cantidad())
+		require.EqualValues(t, valores[0], dic.Obtener(claves[0]))
+		require.EqualValues(t, valores[1], dic.Obtener(claves[1]))
+		require.EqualValues(t, valores[2], dic.Obtener(claves[2]))
+	})
 }
 
 func TestReemplazoDato(t *testing.T) {
 	t.Log("Guarda un par de claves, y luego vuelve a guardar, buscando que el dato se haya reemplazado")
-	clave := "Gato"
-	clave2 := "Perro"
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	dic.Guardar(clave, "miau")
-	dic.Guardar(clave2, "guau")
-	require.True(t, dic.Pertenece(clave))
-	require.True(t, dic.Pertenece(clave2))
-	require.EqualValues(t, "miau", dic.Obtener(clave))
-	require.EqualValues(t, "guau", dic.Obtener(clave2))
-	require.EqualValues(t, 2, dic.Cantidad())
-
-	dic.Guardar(clave, "miu")
-	dic.Guardar(clave2, "baubau")
-	require.True(t, dic.Pertenece(clave))
-	require.True(t, dic.Pertenece(clave2))
-	require.EqualValues(t, 2, dic.Cantidad())
-	require.EqualValues(t, "miu", dic.Obtener(clave))
-	require.EqualValues(t, "baubau", dic.Obtener(clave2))
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		clave := "Gato"
+		clave2 := "Perro"
+		dic := crearHash[string, string](impl, igualdadStrings)
+		dic.Guardar(clave, "miau")
+		dic.Guardar(clave2, "guau")
+		require.True(t, dic.Pertenece(clave))
+		require.True(t, dic.Pertenece(clave2))
+		require.EqualValues(t, "miau", dic.Obtener(clave))
+		require.EqualValues(t, "guau", dic.Obtener(clave2))
+		require.EqualValues(t, 2, dic.Cantidad())
+
+		dic.Guardar(clave, "miu")
+		dic.Guardar(clave2, "baubau")
+		require.True(t, dic.Pertenece(clave))
+		require.True(t, dic.Pertenece(clave2))
+		require.EqualValues(t, 2, dic.Cantidad())
+		require.EqualValues(t, "miu", dic.Obtener(clave))
+		require.EqualValues(t, "baubau", dic.Obtener(clave2))
+	})
 }
 
 func TestReemplazoDatoHopscotch(t *testing.T) {
 	t.Log("Guarda bastantes claves, y luego reemplaza sus datos. Luego valida que todos los datos sean " +
 		"correctos. Para una implementación Hopscotch, detecta errores al hacer lugar o guardar elementos.")
+	paraCadaHash(t, func(t *testing.T, impl string) {
 
-	dic := TDADiccionario.CrearHash[int, int](igualdadInts)
-	for i := 0; i < 500; i++ {
-		dic.Guardar(i, i)
-	}
-	for i := 0; i < 500; i++ {
-		dic.Guardar(i, 2*i)
-	}
-	ok := true
-	for i := 0; i < 500 && ok; i++ {
-		ok = dic.Obtener(i) == 2*i
-	}
-	require.True(t, ok, "Los elementos no fueron actualizados correctamente")
+		dic := crearHash[int, int](impl, igualdadInts)
+		for i := 0; i < 500; i++ {
+			dic.Guardar(i, i)
+		}
+		for i := 0; i < 500; i++ {
+			dic.Guardar(i, 2*i)
+		}
+		ok := true
+		for i := 0; i < 500 && ok; i++ {
+			ok = dic.Obtener(i) == 2*i
+		}
+		require.True(t, ok, "Los elementos no fueron actualizados correctamente")
+	})
 }
 
 func TestDiccionarioBorrar(t *testing.T) {
 	t.Log("Guarda algunos pocos elementos en el diccionario, y se los borra, revisando que en todo momento " +
 		"el diccionario se comporte de manera adecuada")
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	valor1 := "miau"
-	valor2 := "guau"
-	valor3 := "moo"
-	claves := []string{clave1, clave2, clave3}
-	valores := []string{valor1, valor2, valor3}
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-
-	require.False(t, dic.Pertenece(claves[0]))
-	require.False(t, dic.Pertenece(claves[0]))
-	dic.Guardar(claves[0], valores[0])
-	dic.Guardar(claves[1], valores[1])
-	dic.Guardar(claves[2], valores[2])
-
-	require.True(t, dic.Pertenece(claves[2]))
-	require.EqualValues(t, valores[2], dic.Borrar(claves[2]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(claves[2]) })
-	require.EqualValues(t, 2, dic.Cantidad())
-	require.False(t, dic.Pertenece(claves[2]))
-
-	require.True(t, dic.Pertenece(claves[0]))
-	require.EqualValues(t, valores[0], dic.Borrar(claves[0]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(claves[0]) })
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.False(t, dic.Pertenece(claves[0]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener(claves[0]) })
-
-	require.True(t, dic.Pertenece(claves[1]))
-	require.EqualValues(t, valores[1], dic.Borrar(claves[1]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(claves[1]) })
-	require.EqualValues(t, 0, dic.Cantidad())
-	require.False(t, dic.Pertenece(claves[1]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener(claves[1]) })
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		clave1 := "Gato"
+		clave2 := "Perro"
+		clave3 := "Vaca"
+		valor1 := "miau"
+		valor2 := "guau"
+		valor3 := "moo"
+		claves := []string{clave1, clave2, clave3}
+		valores := []string{valor1, valor2, valor3}
+		dic := crearHash[string, string](impl, igualdadStrings)
+
+		require.False(t, dic.Pertenece(claves[0]))
+		require.False(t, dic.Pertenece(claves[0]))
+		dic.Guardar(claves[0], valores[0])
+		dic.Guardar(claves[1], valores[1])
+		dic.Guardar(claves[2], valores[2])
+
+		require.True(t, dic.Pertenece(claves[2]))
+		require.EqualValues(t, valores[2], dic.Borrar(claves[2]))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(claves[2]) })
+		require.EqualValues(t, 2, dic.Cantidad())
+		require.False(t, dic.Pertenece(claves[2]))
+
+		require.True(t, dic.Pertenece(claves[0]))
+		require.EqualValues(t, valores[0], dic.Borrar(claves[0]))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(claves[0]) })
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.False(t, dic.Pertenece(claves[0]))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener(claves[0]) })
+
+		require.True(t, dic.Pertenece(claves[1]))
+		require.EqualValues(t, valores[1], dic.Borrar(claves[1]))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(claves[1]) })
+		require.EqualValues(t, 0, dic.Cantidad())
+		require.False(t, dic.Pertenece(claves[1]))
+		require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener(claves[1]) })
+	})
 }
 
 func TestReutlizacionDeBorrados(t *testing.T) {
 	t.Log("Prueba de caja blanca: revisa, para el caso que fuere un HashCerrado, que no haya problema " +
 		"reinsertando un elemento borrado")
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	clave := "hola"
-	dic.Guardar(clave, "mundo!")
-	dic.Borrar(clave)
-	require.EqualValues(t, 0, dic.Cantidad())
-	require.False(t, dic.Pertenece(clave))
-	dic.Guardar(clave, "mundooo!")
-	require.True(t, dic.Pertenece(clave))
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.EqualValues(t, "mundooo!", dic.Obtener(clave))
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, string](impl, igualdadStrings)
+		clave := "hola"
+		dic.Guardar(clave, "mundo!")
+		dic.Borrar(clave)
+		require.EqualValues(t, 0, dic.Cantidad())
+		require.False(t, dic.Pertenece(clave))
+		dic.Guardar(clave, "mundooo!")
+		require.True(t, dic.Pertenece(clave))
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.EqualValues(t, "mundooo!", dic.Obtener(clave))
+	})
 }
 
 func TestConClavesNumericas(t *testing.T) {
 	t.Log("Valida que no solo funcione con strings")
-	dic := TDADiccionario.CrearHash[int, string](igualdadInts)
-	clave := 10
-	valor := "Gatito"
-
-	dic.Guardar(clave, valor)
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.True(t, dic.Pertenece(clave))
-	require.EqualValues(t, valor, dic.Obtener(clave))
-	require.EqualValues(t, valor, dic.Borrar(clave))
-	require.False(t, dic.Pertenece(clave))
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[int, string](impl, igualdadInts)
+		clave := 10
+		valor := "Gatito"
+
+		dic.Guardar(clave, valor)
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.True(t, dic.Pertenece(clave))
+		require.EqualValues(t, valor, dic.Obtener(clave))
+		require.EqualValues(t, valor, dic.Borrar(clave))
+		require.False(t, dic.Pertenece(clave))
+	})
 }
 
 func TestConClavesStructs(t *testing.T) {
 	t.Log("Valida que tambien funcione con estructuras mas complejas")
-	type basico struct {
-		a string
-		b int
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		type basico struct {
+			a string
+			b int
+		}
+		type avanzado struct {
+			w int
+			x basico
+			y basico
+			z string
+		}
+
+		dic := crearHash[avanzado, int](impl, func(a, b avanzado) bool {
+			return a.w == b.w && a.z == b.z && a.x.a == b.x.a && a.x.b == b.x.b && a.y.a == b.y.a && a.y.b == b.y.b
+		})
+
+		a1 := avanzado{w: 10, z: "hola", x: basico{a: "mundo", b: 8}, y: basico{a: "!", b: 10}}
+		a2 := avanzado{w: 10, z: "aloh", x: basico{a: "odnum", b: 14}, y: basico{a: "!", b: 5}}
+		a3 := avanzado{w: 10, z: "hello", x: basico{a: "world", b: 8}, y: basico{a: "!", b: 4}}
+
+		dic.Guardar(a1, 0)
+		dic.Guardar(a2, 1)
+		dic.Guardar(a3, 2)
+
+		require.True(t, dic.Pertenece(a1))
+		require.True(t, dic.Pertenece(a2))
+		require.True(t, dic.Pertenece(a3))
+		require.EqualValues(t, 0, dic.Obtener(a1))
+		require.EqualValues(t, 1, dic.Obtener(a2))
+		require.EqualValues(t, 2, dic.Obtener(a3))
+		dic.Guardar(a1, 5)
+		require.EqualValues(t, 5, dic.Obtener(a1))
+		require.EqualValues(t, 2, dic.Obtener(a3))
+		require.EqualValues(t, 5, dic.Borrar(a1))
+		require.False(t, dic.Pertenece(a1))
+		require.EqualValues(t, 2, dic.Obtener(a3))
+	})
+}
+
+func TestConFuncionDeHashPropia(t *testing.T) {
+	t.Log("Valida que el hash funcione con una funcion de hash provista por el usuario, incluso si es muy mala " +
+		"y hace colisionar a todas las claves")
+	type punto struct {
+		x, y int
+	}
+	igualdadPuntos := func(a, b punto) bool { return a == b }
+	hashPuntos := func(p punto) uint32 { return TDADiccionario.HashEntero(p.x*31 + p.y) }
+	hashConstante := func(p punto) uint32 { return 7 }
+
+	for _, hash := range []TDADiccionario.FuncionHash[punto]{hashPuntos, hashConstante} {
+		dic := TDADiccionario.CrearHashConFuncion[punto, int](igualdadPuntos, hash)
+		for i := 0; i < 100; i++ {
+			dic.Guardar(punto{i, -i}, i)
+		}
+		require.EqualValues(t, 100, dic.Cantidad())
+		for i := 0; i < 100; i++ {
+			require.EqualValues(t, i, dic.Obtener(punto{i, -i}))
+		}
+		require.False(t, dic.Pertenece(punto{1, 1}))
+		for i := 0; i < 100; i += 2 {
+			require.EqualValues(t, i, dic.Borrar(punto{i, -i}))
+		}
+		require.EqualValues(t, 50, dic.Cantidad())
+		require.True(t, dic.Pertenece(punto{99, -99}))
+	}
+}
+
+func TestHashConBitAltoEncendido(t *testing.T) {
+	t.Log("Valida que los hashes con el bit más alto encendido, que pasados a int de 32 bits serían negativos, " +
+		"no generen posiciones fuera de la tabla")
+	hashAlto := func(clave int) uint32 { return 0x80000000 + uint32(clave) }
+	hashMaximo := func(int) uint32 { return 0xFFFFFFFF }
+
+	for _, hash := range []TDADiccionario.FuncionHash[int]{hashAlto, hashMaximo} {
+		dic := TDADiccionario.CrearHashConFuncion[int, int](igualdadInts, hash)
+		for i := 0; i < 200; i++ {
+			dic.Guardar(i, i*2)
+		}
+		for i := 0; i < 200; i++ {
+			require.True(t, dic.Pertenece(i))
+			require.EqualValues(t, i*2, dic.Obtener(i))
+		}
+		for i := 0; i < 200; i++ {
+			require.EqualValues(t, i*2, dic.Borrar(i))
+		}
+		require.EqualValues(t, 0, dic.Cantidad())
+	}
+}
+
+func TestEstadisticas(t *testing.T) {
+	t.Log("Con una función de hash que manda todas las claves a la misma posición, las estadísticas muestran un " +
+		"único cluster y sondeos cada vez más largos")
+	dic := TDADiccionario.CrearHashConFuncion[int, int](igualdadInts, func(int) uint32 { return 7 })
+	for i := 0; i < 5; i++ {
+		dic.Guardar(i, i)
+	}
+	est := dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+	require.EqualValues(t, 17, est.Capacidad)
+	require.EqualValues(t, 5, est.Cantidad)
+	require.EqualValues(t, 0, est.Borrados)
+	require.InDelta(t, 5.0/17, est.FactorCarga, 1e-9)
+	require.EqualValues(t, 5, est.SondeoMaximo)
+	require.InDelta(t, 3, est.SondeoPromedio, 1e-9)
+	require.Equal(t, map[int]int{5: 1}, est.Clusters)
+	// Cada búsqueda fallida revisa la celda vacía en la que termina, y las del cluster si empieza en él
+	require.InDelta(t, (17+5+4+3+2+1)/17.0, est.SondeoFallidoPromedio, 1e-9)
+
+	// Las celdas borradas siguen formando parte del cluster
+	dic.Borrar(0)
+	est = dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+	require.EqualValues(t, 4, est.Cantidad)
+	require.EqualValues(t, 1, est.Borrados)
+	require.InDelta(t, 5.0/17, est.FactorCarga, 1e-9)
+	require.EqualValues(t, 5, est.SondeoMaximo)
+	require.InDelta(t, 3.5, est.SondeoPromedio, 1e-9)
+	require.Equal(t, map[int]int{5: 1}, est.Clusters)
+}
+
+func TestEstadisticasRobinHood(t *testing.T) {
+	t.Log("Las estadísticas del hash Robin Hood cuentan a todas las claves dentro de algún cluster")
+	dic := TDADiccionario.CrearHashRobinHood[int, int](igualdadInts)
+	est := dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+	require.EqualValues(t, 0, est.SondeoMaximo)
+	require.Empty(t, est.Clusters)
+
+	for i := 0; i < 1000; i++ {
+		dic.Guardar(i, i)
+	}
+	est = dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+	require.EqualValues(t, 1000, est.Cantidad)
+	require.EqualValues(t, 0, est.Borrados)
+	require.GreaterOrEqual(t, est.SondeoPromedio, 1.0)
+	require.GreaterOrEqual(t, float64(est.SondeoMaximo), est.SondeoPromedio)
+	require.GreaterOrEqual(t, est.SondeoFallidoPromedio, 1.0)
+
+	enClusters := 0
+	for tam, cant := range est.Clusters {
+		enClusters += tam * cant
+	}
+	require.EqualValues(t, 1000, enClusters)
+
+	// Con todas las claves en la misma posición, las búsquedas fallidas recorren el mismo cluster que en el
+	// sondeo lineal
+	dic = TDADiccionario.CrearHashRobinHoodConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+		Hash: func(int) uint32 { return 7 },
+	})
+	for i := 0; i < 5; i++ {
+		dic.Guardar(i, i)
+	}
+	est = dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+	require.InDelta(t, 3, est.SondeoPromedio, 1e-9)
+	require.InDelta(t, (17+5+4+3+2+1)/17.0, est.SondeoFallidoPromedio, 1e-9)
+}
+
+func TestHashConOpciones(t *testing.T) {
+	t.Log("Las opciones permiten reservar lugar de antemano, cambiar los factores de carga y evitar que la " +
+		"tabla se achique")
+	capacidad := func(dic TDADiccionario.Diccionario[int, int]) int {
+		return dic.(TDADiccionario.ConEstadisticas).Estadisticas().Capacidad
+	}
+
+	dic := TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+		ClavesEsperadas: 10000,
+	})
+	inicial := capacidad(dic)
+	for i := 0; i < 10000; i++ {
+		dic.Guardar(i, i)
+	}
+	require.EqualValues(t, inicial, capacidad(dic), "No deberia redimensionar mientras se cargan las claves esperadas")
+	for i := 0; i < 10000; i++ {
+		dic.Borrar(i)
+	}
+	require.EqualValues(t, inicial, capacidad(dic), "No deberia achicarse por debajo de lo reservado")
+
+	dic = TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+		SinAchicar: true,
+	})
+	for i := 0; i < 1000; i++ {
+		dic.Guardar(i, i)
+	}
+	llena := capacidad(dic)
+	for i := 0; i < 1000; i++ {
+		require.EqualValues(t, i, dic.Borrar(i))
+	}
+	require.EqualValues(t, llena, capacidad(dic))
+
+	dic = TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+		FactorCargaMax:    0.5,
+		FactorRedimension: 3,
+	})
+	for i := 0; i < 10; i++ {
+		dic.Guardar(i, i)
+	}
+	require.EqualValues(t, 51, capacidad(dic))
+	for i := 0; i < 10; i++ {
+		require.EqualValues(t, i, dic.Obtener(i))
+	}
+
+	for _, opciones := range []TDADiccionario.OpcionesHash[int]{
+		{FactorCargaMax: 1},
+		{FactorCargaMax: -0.5},
+		{FactorCargaMax: 0.3, FactorCargaMin: 0.3},
+		{FactorCargaMax: 0.5, FactorCargaMin: 0.3},
+		{FactorCargaMax: 0.9, FactorCargaMin: 0.2, FactorRedimension: 5},
+		{FactorRedimension: 1},
+		{ClavesEsperadas: -1},
+	} {
+		require.PanicsWithValue(t, "Las opciones del hash son invalidas", func() {
+			TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, opciones)
+		}, "%+v", opciones)
+		require.PanicsWithValue(t, "Las opciones del hash son invalidas", func() {
+			TDADiccionario.CrearHashRobinHoodConOpciones[int, int](igualdadInts, opciones)
+		}, "%+v", opciones)
+	}
+
+	// El Robin Hood acepta las mismas opciones, salvo la redimensión incremental
+	dic = TDADiccionario.CrearHashRobinHoodConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+		ClavesEsperadas: 900,
+		FactorCargaMax:  0.95,
+	})
+	inicial = capacidad(dic)
+	for i := 0; i < 900; i++ {
+		dic.Guardar(i, i)
+	}
+	require.EqualValues(t, inicial, capacidad(dic))
+	require.Greater(t, dic.(TDADiccionario.ConEstadisticas).Estadisticas().FactorCarga, 0.9)
+	require.PanicsWithValue(t, "Las opciones del hash son invalidas", func() {
+		TDADiccionario.CrearHashRobinHoodConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+			RedimensionIncremental: true,
+		})
+	})
+}
+
+func TestHashConOpcionesSinRedimensionarDeMas(t *testing.T) {
+	t.Log("Con cualquier combinación válida de factores, guardar y borrar una clave en el límite de una " +
+		"redimensión no hace que la tabla se agrande y se achique cada vez. Si sólo se indica alguno de los " +
+		"factores, el mínimo por defecto se ajusta a los demás")
+	for _, opciones := range []TDADiccionario.OpcionesHash[int]{
+		{},
+		{FactorCargaMax: 0.15},
+		{FactorRedimension: 4},
+		{FactorCargaMax: 0.9, FactorRedimension: 1.5},
+		{FactorCargaMax: 0.5, FactorCargaMin: 0.2},
+	} {
+		dic := TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, opciones)
+		capacidad := func() int {
+			return dic.(TDADiccionario.ConEstadisticas).Estadisticas().Capacidad
+		}
+		// alternar guarda y borra repetidas veces, y cuenta cuántas veces cambió la capacidad
+		alternar := func(guardar bool, clave int) int {
+			redimensiones, anterior := 0, capacidad()
+			for i := 0; i < 1000; i++ {
+				if guardar {
+					dic.Guardar(clave, clave)
+					dic.Borrar(clave)
+				} else {
+					dic.Borrar(clave)
+					dic.Guardar(clave, clave)
+				}
+				if capacidad() != anterior {
+					redimensiones++
+					anterior = capacidad()
+				}
+			}
+			return redimensiones
+		}
+
+		// Recién agrandada, la tabla no debería achicarse al borrar una clave. Se agranda dos veces porque nunca
+		// se achica por debajo de la capacidad inicial.
+		n := 0
+		for agrandadas, anterior := 0, capacidad(); agrandadas < 2; n++ {
+			dic.Guardar(n, n)
+			if capacidad() != anterior {
+				agrandadas++
+				anterior = capacidad()
+			}
+		}
+		require.LessOrEqual(t, alternar(false, n-1), 1, "%+v", opciones)
+
+		// Recién achicada, no debería agrandarse al guardar una clave
+		for achicada := capacidad(); capacidad() == achicada; {
+			n--
+			dic.Borrar(n)
+		}
+		require.LessOrEqual(t, alternar(true, n), 1, "%+v", opciones)
+	}
+}
+
+func TestRedimensionIncremental(t *testing.T) {
+	t.Log("Durante una redimensión incremental las claves están repartidas entre ambas tablas, y todas se " +
+		"pueden obtener, actualizar, borrar e iterar")
+	dic := TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+		RedimensionIncremental: true,
+	})
+	pendientes := func() int {
+		return dic.(TDADiccionario.ConEstadisticas).Estadisticas().PendientesDeMigrar
+	}
+
+	n := 0
+	for pendientes() == 0 {
+		dic.Guardar(n, n)
+		n++
+	}
+	require.Greater(t, pendientes(), 0)
+	require.EqualValues(t, n, dic.Cantidad())
+	for i := 0; i < n; i++ {
+		require.True(t, dic.Pertenece(i))
+		require.EqualValues(t, i, dic.Obtener(i))
+	}
+	visitados := 0
+	dic.Iterar(func(int, int) bool {
+		visitados++
+		return true
+	})
+	require.EqualValues(t, n, visitados)
+
+	// Actualizamos y borramos claves que pueden estar en cualquiera de las dos tablas
+	for i := 0; i < n; i += 2 {
+		dic.Guardar(i, -i)
+	}
+	for i := 1; i < n; i += 2 {
+		require.EqualValues(t, i, dic.Borrar(i))
+		require.False(t, dic.Pertenece(i))
+	}
+	require.EqualValues(t, (n+1)/2, dic.Cantidad())
+	for i := 0; i < n; i += 2 {
+		require.EqualValues(t, -i, dic.Obtener(i))
+	}
+
+	// Las siguientes operaciones terminan de migrar
+	for i := n; pendientes() > 0; i++ {
+		dic.Guardar(i, i)
+	}
+	for i := 0; i < n; i += 2 {
+		require.EqualValues(t, -i, dic.Obtener(i))
+	}
+}
+
+func TestRedimensionIncrementalConColisiones(t *testing.T) {
+	t.Log("Durante una redimensión incremental se siguen encontrando las claves de la tabla vieja cuyo cluster " +
+		"empieza en posiciones que ya se migraron")
+	dic := TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+		Hash:                   func(int) uint32 { return 0 },
+		RedimensionIncremental: true,
+	})
+	pendientes := func() int {
+		return dic.(TDADiccionario.ConEstadisticas).Estadisticas().PendientesDeMigrar
+	}
+
+	n := 0
+	for pendientes() == 0 {
+		dic.Guardar(n, n)
+		n++
+	}
+	for i := 0; i < n; i++ {
+		dic.Guardar(i, -i)
+		require.EqualValues(t, n, dic.Cantidad())
+	}
+	for i := 0; i < n; i++ {
+		require.EqualValues(t, -i, dic.Obtener(i))
+	}
+}
+
+func TestRedimensionIncrementalIterando(t *testing.T) {
+	t.Log("Durante una redimensión incremental, actualizar claves no invalida al iterador ni le hace saltear " +
+		"claves, y guardar una nueva o compactar sí lo invalidan")
+	dic := TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+		RedimensionIncremental: true,
+	})
+	pendientes := func() int {
+		return dic.(TDADiccionario.ConEstadisticas).Estadisticas().PendientesDeMigrar
+	}
+	n := 0
+	for pendientes() == 0 {
+		dic.Guardar(n, n)
+		n++
+	}
+
+	vistas := make(map[int]int)
+	for iter := dic.Iterador(); iter.HaySiguiente(); iter.Siguiente() {
+		clave, _ := iter.VerActual()
+		vistas[clave]++
+		dic.Guardar(clave, -clave)
+		dic.Guardar((clave+1)%n, 0)
+	}
+	require.Len(t, vistas, n)
+	for i := 0; i < n; i++ {
+		require.EqualValues(t, 1, vistas[i])
+	}
+	require.Greater(t, pendientes(), 0)
+
+	iter := dic.Iterador()
+	dic.(TDADiccionario.Compactable).Compactar()
+	require.EqualValues(t, 0, pendientes())
+	require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+
+	iter = dic.Iterador()
+	dic.Guardar(n, n)
+	require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+}
+
+func TestCompactar(t *testing.T) {
+	t.Log("Compactar vacía las celdas borradas sin cambiar la capacidad, y todas las claves se siguen encontrando " +
+		"aunque colisionen o sus clusters den la vuelta a la tabla")
+	hashConstante := func(int) uint32 { return 15 }
+	hashAlFinal := func(clave int) uint32 { return uint32(14 + clave%3) }
+
+	for _, hash := range []TDADiccionario.FuncionHash[int]{hashConstante, hashAlFinal} {
+		dic := TDADiccionario.CrearHashConFuncion[int, int](igualdadInts, hash)
+		for i := 0; i < 11; i++ {
+			dic.Guardar(i, i)
+		}
+		for i := 0; i < 11; i += 3 {
+			dic.Borrar(i)
+		}
+		est := dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+		require.Greater(t, est.Borrados, 0)
+
+		iter := dic.Iterador()
+		dic.(TDADiccionario.Compactable).Compactar()
+		require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.Siguiente() })
+
+		est = dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+		require.EqualValues(t, 0, est.Borrados)
+		require.EqualValues(t, 17, est.Capacidad)
+		require.EqualValues(t, 7, dic.Cantidad())
+		for i := 0; i < 11; i++ {
+			require.Equal(t, i%3 != 0, dic.Pertenece(i))
+		}
+		visitados := 0
+		dic.Iterar(func(int, int) bool {
+			visitados++
+			return true
+		})
+		require.EqualValues(t, 7, visitados)
+	}
+}
+
+func TestCompactarAutomaticamente(t *testing.T) {
+	t.Log("Con una cantidad de claves estable, las celdas borradas se compactan solas en lugar de agrandar la " +
+		"tabla o llenarla de celdas borradas")
+	dic := TDADiccionario.CrearHash[int, int](igualdadInts)
+	for i := 0; i < 8; i++ {
+		dic.Guardar(i, i)
+	}
+	capacidad := 0
+	for i := 8; i < 10000; i++ {
+		dic.Borrar(i - 8)
+		dic.Guardar(i, i)
+
+		est := dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+		require.LessOrEqual(t, float64(est.Borrados), float64(est.Capacidad)*TDADiccionario.FACTOR_BORRADOS_MAX)
+		// La tabla puede agrandarse una vez, pero después la capacidad se mantiene
+		if i == 1000 {
+			capacidad = est.Capacidad
+		} else if i > 1000 {
+			require.EqualValues(t, capacidad, est.Capacidad)
+		}
+	}
+	for i := 10000 - 8; i < 10000; i++ {
+		require.EqualValues(t, i, dic.Obtener(i))
+	}
+}
+
+func TestActualizarIterandoConBorrados(t *testing.T) {
+	t.Log("Actualizar datos mientras se itera una tabla llena de celdas borradas no la compacta, así que el " +
+		"iterador sigue siendo válido y ve cada clave una vez")
+	dic := TDADiccionario.CrearHash[int, int](igualdadInts)
+	for i := 0; i < 8; i++ {
+		dic.Guardar(i, i)
+	}
+	for i := 8; i < 2000; i++ {
+		dic.Borrar(i - 8)
+		dic.Guardar(i, i)
+
+		vistas := 0
+		for iter := dic.Iterador(); iter.HaySiguiente(); iter.Siguiente() {
+			clave, dato := iter.VerActual()
+			dic.Guardar(clave, dato+1)
+			vistas++
+		}
+		require.EqualValues(t, 8, vistas)
 	}
-	type avanzado struct {
-		w int
-		x basico
-		y basico
-		z string
+	// Cada clave se actualizó una vez por cada iteración desde que se guardó
+	for i := 2000 - 8; i < 2000; i++ {
+		require.EqualValues(t, 2000, dic.Obtener(i))
+	}
+}
+
+func TestHashConSemilla(t *testing.T) {
+	t.Log("Dos tablas con la misma semilla ubican las claves igual, y con semillas distintas las ubican distinto")
+	ordenSegun := func(opciones TDADiccionario.OpcionesHash[string]) []string {
+		dic := TDADiccionario.CrearHashConOpciones[string, int](igualdadStrings, opciones)
+		for i := 0; i < 100; i++ {
+			dic.Guardar(fmt.Sprintf("clave%d", i), i)
+		}
+		orden := []string{}
+		dic.Iterar(func(clave string, _ int) bool {
+			orden = append(orden, clave)
+			return true
+		})
+		return orden
 	}
 
-	dic := TDADiccionario.CrearHash[avanzado, int](func(a, b avanzado) bool {
-		return a.w == b.w && a.z == b.z && a.x.a == b.x.a && a.x.b == b.x.b && a.y.a == b.y.a && a.y.b == b.y.b
+	require.Equal(t, ordenSegun(TDADiccionario.OpcionesHash[string]{Semilla: 1}),
+		ordenSegun(TDADiccionario.OpcionesHash[string]{Semilla: 1}))
+	require.NotEqual(t, ordenSegun(TDADiccionario.OpcionesHash[string]{Semilla: 1}),
+		ordenSegun(TDADiccionario.OpcionesHash[string]{Semilla: 2}))
+
+	conSip := TDADiccionario.OpcionesHash[string]{HashConSemilla: TDADiccionario.HashSipCadena[string], Semilla: 1}
+	require.Equal(t, ordenSegun(conSip), ordenSegun(conSip))
+	require.Len(t, ordenSegun(conSip), 100)
+
+	conComparable := TDADiccionario.OpcionesHash[string]{
+		HashConSemilla: TDADiccionario.HashComparableConSemilla[string],
+		Semilla:        1,
+	}
+	ordenSemillaUno := ordenSegun(conComparable)
+	require.Equal(t, ordenSemillaUno, ordenSegun(conComparable))
+	conComparable.Semilla = 2
+	require.NotEqual(t, ordenSemillaUno, ordenSegun(conComparable))
+	require.NotEqualValues(t, TDADiccionario.HashComparableConSemilla("Gato", 1),
+		TDADiccionario.HashComparableConSemilla("Gato", 2))
+
+	require.NotEqualValues(t, TDADiccionario.HashSipCadena("Gato", 1), TDADiccionario.HashSipCadena("Gato", 2))
+	require.EqualValues(t, TDADiccionario.HashSipCadena("Gato", 1), TDADiccionario.HashSipBytes([]byte("Gato"), 1))
+
+	require.PanicsWithValue(t, "Las opciones del hash son invalidas", func() {
+		TDADiccionario.CrearHashConOpciones[string, int](igualdadStrings, TDADiccionario.OpcionesHash[string]{
+			Hash:           TDADiccionario.HashCadena[string],
+			HashConSemilla: TDADiccionario.HashSipCadena[string],
+		})
 	})
+}
 
-	a1 := avanzado{w: 10, z: "hola", x: basico{a: "mundo", b: 8}, y: basico{a: "!", b: 10}}
-	a2 := avanzado{w: 10, z: "aloh", x: basico{a: "odnum", b: 14}, y: basico{a: "!", b: 5}}
-	a3 := avanzado{w: 10, z: "hello", x: basico{a: "world", b: 8}, y: basico{a: "!", b: 4}}
+func TestFuncionesDeHashIncluidas(t *testing.T) {
+	t.Log("Las funciones de hash incluidas son deterministas, y la de cadenas coincide con la genérica")
+	require.EqualValues(t, TDADiccionario.HashGenerico("Gato"), TDADiccionario.HashCadena("Gato"))
+	require.EqualValues(t, TDADiccionario.HashCadena("Gato"), TDADiccionario.HashBytes([]byte("Gato")))
+	require.EqualValues(t, TDADiccionario.HashEntero(12345), TDADiccionario.HashEntero(12345))
+	require.NotEqualValues(t, TDADiccionario.HashEntero(1), TDADiccionario.HashEntero(2))
+
+	dic := TDADiccionario.CrearHashConFuncion[[]byte, int](func(a, b []byte) bool {
+		return string(a) == string(b)
+	}, TDADiccionario.HashBytes[[]byte])
+	dic.Guardar([]byte("A"), 1)
+	dic.Guardar([]byte("B"), 2)
+	require.EqualValues(t, 1, dic.Obtener([]byte("A")))
+	require.EqualValues(t, 2, dic.Obtener([]byte("B")))
+}
+
+func TestHashPorDefectoConTiposDefinidos(t *testing.T) {
+	t.Log("Las claves de tipos definidos a partir de cadenas, bytes o enteros usan el hash especializado, que " +
+		"no formatea la clave como texto ni reserva memoria")
+	type nombre string
+	dicNombres := TDADiccionario.CrearHash[nombre, int](func(a, b nombre) bool { return a == b })
+	dicChicos := TDADiccionario.CrearHash[int8, int](func(a, b int8) bool { return a == b })
+	dicMedianos := TDADiccionario.CrearHash[uint16, int](func(a, b uint16) bool { return a == b })
+	for i := 0; i < 200; i++ {
+		dicNombres.Guardar(nombre(fmt.Sprintf("n%d", i)), i)
+		dicChicos.Guardar(int8(i-100), i)
+		dicMedianos.Guardar(uint16(i*300), i)
+	}
+	for i := 0; i < 200; i++ {
+		require.EqualValues(t, i, dicNombres.Obtener(nombre(fmt.Sprintf("n%d", i))))
+		require.EqualValues(t, i, dicChicos.Obtener(int8(i-100)))
+		require.EqualValues(t, i, dicMedianos.Obtener(uint16(i*300)))
+	}
+
+	require.Zero(t, testing.AllocsPerRun(100, func() { dicNombres.Obtener("n7") }))
+	require.Zero(t, testing.AllocsPerRun(100, func() { dicChicos.Obtener(-3) }))
+	require.Zero(t, testing.AllocsPerRun(100, func() { dicMedianos.Obtener(600) }))
+}
+
+func TestHashComparable(t *testing.T) {
+	t.Log("Un hash de claves comparables no necesita funcion de igualdad ni de hash")
+	dic := TDADiccionario.CrearHashComparable[string, int]()
+	require.False(t, dic.Pertenece(""))
+	dic.Guardar("Gato", 1)
+	dic.Guardar("Perro", 2)
+	dic.Guardar("Gato", 3)
+	require.EqualValues(t, 2, dic.Cantidad())
+	require.EqualValues(t, 3, dic.Obtener("Gato"))
+	require.EqualValues(t, 2, dic.Borrar("Perro"))
+	require.False(t, dic.Pertenece("Perro"))
+
+	type basico struct {
+		a string
+		b int
+	}
+	dicStructs := TDADiccionario.CrearHashComparable[basico, int]()
+	for i := 0; i < 1000; i++ {
+		dicStructs.Guardar(basico{fmt.Sprintf("%d", i), i}, i)
+	}
+	require.EqualValues(t, 1000, dicStructs.Cantidad())
+	for i := 0; i < 1000; i++ {
+		require.EqualValues(t, i, dicStructs.Obtener(basico{fmt.Sprintf("%d", i), i}))
+	}
+	require.False(t, dicStructs.Pertenece(basico{"1", 2}))
+
+	// Los punteros se comparan por direccion, aunque apunten a valores iguales
+	x, y := 5, 5
+	dicPunteros := TDADiccionario.CrearHashComparable[*int, string]()
+	dicPunteros.Guardar(&x, "x")
+	require.True(t, dicPunteros.Pertenece(&x))
+	require.False(t, dicPunteros.Pertenece(&y))
+}
 
-	dic.Guardar(a1, 0)
-	dic.Guardar(a2, 1)
-	dic.Guardar(a3, 2)
+func TestGuardarYBorrarAleatorio(t *testing.T) {
+	t.Log("Guarda, obtiene y borra claves al azar, comparando siempre contra un map de Go")
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[int, int](impl, igualdadInts)
+		esperado := make(map[int]int)
+		aleatorio := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 20000; i++ {
+			clave := aleatorio.Intn(2000)
+			if aleatorio.Intn(3) == 0 {
+				if _, esta := esperado[clave]; esta {
+					require.EqualValues(t, esperado[clave], dic.Borrar(clave))
+					delete(esperado, clave)
+				} else {
+					require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(clave) })
+				}
+			} else {
+				dic.Guardar(clave, i)
+				esperado[clave] = i
+			}
+			require.EqualValues(t, len(esperado), dic.Cantidad())
+		}
 
-	require.True(t, dic.Pertenece(a1))
-	require.True(t, dic.Pertenece(a2))
-	require.True(t, dic.Pertenece(a3))
-	require.EqualValues(t, 0, dic.Obtener(a1))
-	require.EqualValues(t, 1, dic.Obtener(a2))
-	require.EqualValues(t, 2, dic.Obtener(a3))
-	dic.Guardar(a1, 5)
-	require.EqualValues(t, 5, dic.Obtener(a1))
-	require.EqualValues(t, 2, dic.Obtener(a3))
-	require.EqualValues(t, 5, dic.Borrar(a1))
-	require.False(t, dic.Pertenece(a1))
-	require.EqualValues(t, 2, dic.Obtener(a3))
+		for clave := 0; clave < 2000; clave++ {
+			valor, esta := esperado[clave]
+			require.EqualValues(t, esta, dic.Pertenece(clave))
+			if esta {
+				require.EqualValues(t, valor, dic.Obtener(clave))
+			}
+		}
 
+		visitados := 0
+		for iter := dic.Iterador(); iter.HaySiguiente(); iter.Siguiente() {
+			clave, valor := iter.VerActual()
+			require.EqualValues(t, esperado[clave], valor)
+			visitados++
+		}
+		require.EqualValues(t, len(esperado), visitados)
+	})
 }
 
 func TestClaveVacia(t *testing.T) {
 	t.Log("Guardamos una clave vacía (i.e. \"\") y deberia funcionar sin problemas")
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	clave := ""
-	dic.Guardar(clave, clave)
-	require.True(t, dic.Pertenece(clave))
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.EqualValues(t, clave, dic.Obtener(clave))
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, string](impl, igualdadStrings)
+		clave := ""
+		dic.Guardar(clave, clave)
+		require.True(t, dic.Pertenece(clave))
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.EqualValues(t, clave, dic.Obtener(clave))
+	})
 }
 
 func TestValorNulo(t *testing.T) {
 	t.Log("Probamos que el valor puede ser nil sin problemas")
-	dic := TDADiccionario.CrearHash[string, *int](igualdadStrings)
-	clave := "Pez"
-	dic.Guardar(clave, nil)
-	require.True(t, dic.Pertenece(clave))
-	require.EqualValues(t, 1, dic.Cantidad())
-	require.EqualValues(t, (*int)(nil), dic.Obtener(clave))
-	require.EqualValues(t, (*int)(nil), dic.Borrar(clave))
-	require.False(t, dic.Pertenece(clave))
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, *int](impl, igualdadStrings)
+		clave := "Pez"
+		dic.Guardar(clave, nil)
+		require.True(t, dic.Pertenece(clave))
+		require.EqualValues(t, 1, dic.Cantidad())
+		require.EqualValues(t, (*int)(nil), dic.Obtener(clave))
+		require.EqualValues(t, (*int)(nil), dic.Borrar(clave))
+		require.False(t, dic.Pertenece(clave))
+	})
 }
 
 func TestCadenaLargaParticular(t *testing.T) {
 	t.Log("Se han visto casos problematicos al utilizar la funcion de hashing de K&R, por lo que " +
 		"se agrega una prueba con dicha funcion de hashing y una cadena muy larga")
-	// El caracter '~' es el de mayor valor en ASCII (126).
-	claves := make([]string, 10)
-	cadena := "%d~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~" +
-		"~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~"
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	valores := []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"}
-	for i := 0; i < 10; i++ {
-		claves[i] = fmt.Sprintf(cadena, i)
-		dic.Guardar(claves[i], valores[i])
-	}
-	require.EqualValues(t, 10, dic.Cantidad())
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		// El caracter '~' es el de mayor valor en ASCII (126).
+		claves := make([]string, 10)
+		cadena := "%d~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~" +
+			"~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~"
+		dic := crearHash[string, string](impl, igualdadStrings)
+		valores := []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"}
+		for i := 0; i < 10; i++ {
+			claves[i] = fmt.Sprintf(cadena, i)
+			dic.Guardar(claves[i], valores[i])
+		}
+		require.EqualValues(t, 10, dic.Cantidad())
 
-	ok := true
-	for i := 0; i < 10 && ok; i++ {
-		ok = dic.Obtener(claves[i]) == valores[i]
-	}
+		ok := true
+		for i := 0; i < 10 && ok; i++ {
+			ok = dic.Obtener(claves[i]) == valores[i]
+		}
 
-	require.True(t, ok, "Obtener clave larga funciona")
+		require.True(t, ok, "Obtener clave larga funciona")
+	})
 }
 
 func TestGuardarYBorrarRepetidasVeces(t *testing.T) {
 	t.Log("Esta prueba guarda y borra repetidas veces. Esto lo hacemos porque un error comun es no considerar " +
 		"los borrados para agrandar en un Hash Cerrado. Si no se agranda, muy probablemente se quede en un ciclo " +
 		"infinito")
-
-	dic := TDADiccionario.CrearHash[int, int](igualdadInts)
-	for i := 0; i < 1000; i++ {
-		dic.Guardar(i, i)
-		require.True(t, dic.Pertenece(i))
-		dic.Borrar(i)
-		require.False(t, dic.Pertenece(i))
-	}
+	paraCadaHash(t, func(t *testing.T, impl string) {
+
+		dic := crearHash[int, int](impl, igualdadInts)
+		for i := 0; i < 1000; i++ {
+			dic.Guardar(i, i)
+			require.True(t, dic.Pertenece(i))
+			dic.Borrar(i)
+			require.False(t, dic.Pertenece(i))
+		}
+	})
 }
 
 func buscar(clave string, claves []string) int {
@@ -312,90 +976,96 @@ func buscar(clave string, claves []string) int {
 
 func TestIteradorInternoClaves(t *testing.T) {
 	t.Log("Valida que todas las claves sean recorridas (y una única vez) con el iterador interno")
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	claves := []string{clave1, clave2, clave3}
-	dic := TDADiccionario.CrearHash[string, *int](igualdadStrings)
-	dic.Guardar(claves[0], nil)
-	dic.Guardar(claves[1], nil)
-	dic.Guardar(claves[2], nil)
-
-	cs := []string{"", "", ""}
-	cantidad := 0
-	cantPtr := &cantidad
-
-	dic.Iterar(func(clave string, dato *int) bool {
-		cs[cantidad] = clave
-		*cantPtr = *cantPtr + 1
-		return true
-	})
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		clave1 := "Gato"
+		clave2 := "Perro"
+		clave3 := "Vaca"
+		claves := []string{clave1, clave2, clave3}
+		dic := crearHash[string, *int](impl, igualdadStrings)
+		dic.Guardar(claves[0], nil)
+		dic.Guardar(claves[1], nil)
+		dic.Guardar(claves[2], nil)
+
+		cs := []string{"", "", ""}
+		cantidad := 0
+		cantPtr := &cantidad
+
+		dic.Iterar(func(clave string, dato *int) bool {
+			cs[cantidad] = clave
+			*cantPtr = *cantPtr + 1
+			return true
+		})
 
-	require.EqualValues(t, 3, cantidad)
-	require.NotEqualValues(t, -1, buscar(cs[0], claves))
-	require.NotEqualValues(t, -1, buscar(cs[1], claves))
-	require.NotEqualValues(t, -1, buscar(cs[2], claves))
-	require.NotEqualValues(t, cs[0], cs[1])
-	require.NotEqualValues(t, cs[0], cs[2])
-	require.NotEqualValues(t, cs[2], cs[1])
+		require.EqualValues(t, 3, cantidad)
+		require.NotEqualValues(t, -1, buscar(cs[0], claves))
+		require.NotEqualValues(t, -1, buscar(cs[1], claves))
+		require.NotEqualValues(t, -1, buscar(cs[2], claves))
+		require.NotEqualValues(t, cs[0], cs[1])
+		require.NotEqualValues(t, cs[0], cs[2])
+		require.NotEqualValues(t, cs[2], cs[1])
+	})
 }
 
 func TestIteradorInternoValores(t *testing.T) {
 	t.Log("Valida que los datos sean recorridas correctamente (y una única vez) con el iterador interno")
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	clave4 := "Burrito"
-	clave5 := "Hamster"
-
-	dic := TDADiccionario.CrearHash[string, int](igualdadStrings)
-	dic.Guardar(clave1, 6)
-	dic.Guardar(clave2, 2)
-	dic.Guardar(clave3, 3)
-	dic.Guardar(clave4, 4)
-	dic.Guardar(clave5, 5)
-
-	factorial := 1
-	ptrFactorial := &factorial
-	dic.Iterar(func(_ string, dato int) bool {
-		*ptrFactorial *= dato
-		return true
-	})
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		clave1 := "Gato"
+		clave2 := "Perro"
+		clave3 := "Vaca"
+		clave4 := "Burrito"
+		clave5 := "Hamster"
+
+		dic := crearHash[string, int](impl, igualdadStrings)
+		dic.Guardar(clave1, 6)
+		dic.Guardar(clave2, 2)
+		dic.Guardar(clave3, 3)
+		dic.Guardar(clave4, 4)
+		dic.Guardar(clave5, 5)
+
+		factorial := 1
+		ptrFactorial := &factorial
+		dic.Iterar(func(_ string, dato int) bool {
+			*ptrFactorial *= dato
+			return true
+		})
 
-	require.EqualValues(t, 720, factorial)
+		require.EqualValues(t, 720, factorial)
+	})
 }
 
 func TestIteradorInternoValoresConBorrados(t *testing.T) {
 	t.Log("Valida que los datos sean recorridas correctamente (y una única vez) con el iterador interno, sin recorrer datos borrados")
-	clave0 := "Elefante"
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	clave4 := "Burrito"
-	clave5 := "Hamster"
-
-	dic := TDADiccionario.CrearHash[string, int](igualdadStrings)
-	dic.Guardar(clave0, 7)
-	dic.Guardar(clave1, 6)
-	dic.Guardar(clave2, 2)
-	dic.Guardar(clave3, 3)
-	dic.Guardar(clave4, 4)
-	dic.Guardar(clave5, 5)
-
-	dic.Borrar(clave0)
-
-	factorial := 1
-	ptrFactorial := &factorial
-	dic.Iterar(func(_ string, dato int) bool {
-		*ptrFactorial *= dato
-		return true
-	})
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		clave0 := "Elefante"
+		clave1 := "Gato"
+		clave2 := "Perro"
+		clave3 := "Vaca"
+		clave4 := "Burrito"
+		clave5 := "Hamster"
+
+		dic := crearHash[string, int](impl, igualdadStrings)
+		dic.Guardar(clave0, 7)
+		dic.Guardar(clave1, 6)
+		dic.Guardar(clave2, 2)
+		dic.Guardar(clave3, 3)
+		dic.Guardar(clave4, 4)
+		dic.Guardar(clave5, 5)
+
+		dic.Borrar(clave0)
+
+		factorial := 1
+		ptrFactorial := &factorial
+		dic.Iterar(func(_ string, dato int) bool {
+			*ptrFactorial *= dato
+			return true
+		})
 
-	require.EqualValues(t, 720, factorial)
+		require.EqualValues(t, 720, factorial)
+	})
 }
 
-func ejecutarPruebaVolumen(b *testing.B, n int) {
-	dic := TDADiccionario.CrearHash[string, int](igualdadStrings)
+func ejecutarPruebaVolumen(b *testing.B, impl string, n int) {
+	dic := crearHash[string, int](impl, igualdadStrings)
 
 	claves := make([]string, n)
 	valores := make([]int, n)
@@ -446,126 +1116,351 @@ func BenchmarkDiccionario(b *testing.B) {
 		"ejecutando muchas veces las pruebas para generar un benchmark. Valida que la cantidad " +
 		"sea la adecuada. Luego validamos que podemos obtener y ver si pertenece cada una de las claves geeneradas, " +
 		"y que luego podemos borrar sin problemas")
-	for _, n := range TAMS_VOLUMEN {
-		b.Run(fmt.Sprintf("Prueba %d elementos", n), func(b *testing.B) {
+	for _, impl := range IMPLEMENTACIONES_HASH {
+		for _, n := range TAMS_VOLUMEN {
+			b.Run(fmt.Sprintf("%s %d elementos", impl, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					ejecutarPruebaVolumen(b, impl, n)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkFuncionesHash(b *testing.B) {
+	b.Log("Compara el costo y la memoria reservada por cada función de hash incluida contra la genérica, " +
+		"que formatea la clave como texto")
+	cadena := "clave-de-prueba-00001234"
+	bytes := []byte(cadena)
+	b.Run("Generica string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			TDADiccionario.HashGenerico(cadena)
+		}
+	})
+	b.Run("Cadena", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			TDADiccionario.HashCadena(cadena)
+		}
+	})
+	b.Run("Bytes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			TDADiccionario.HashBytes(bytes)
+		}
+	})
+	b.Run("SipHash string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			TDADiccionario.HashSipCadena(cadena, uint64(i))
+		}
+	})
+	b.Run("Comparable string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			TDADiccionario.HashComparable(cadena)
+		}
+	})
+	b.Run("Generica int", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			TDADiccionario.HashGenerico(i)
+		}
+	})
+	b.Run("Entero", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			TDADiccionario.HashEntero(i)
+		}
+	})
+}
+
+func BenchmarkObtenerSegunFuncionHash(b *testing.B) {
+	b.Log("Compara Obtener sobre un hash con claves struct usando la función genérica contra una provista por " +
+		"el usuario")
+	type punto struct {
+		x, y int
+	}
+	const n = 10000
+	igualdadPuntos := func(a, b punto) bool { return a == b }
+	nombres := []string{"Generica", "Comparable", "Propia"}
+	hashes := []TDADiccionario.FuncionHash[punto]{
+		TDADiccionario.HashGenerico[punto],
+		TDADiccionario.HashComparable[punto],
+		func(p punto) uint32 { return TDADiccionario.HashEntero(p.x*31 + p.y) },
+	}
+	for j, nombre := range nombres {
+		dic := TDADiccionario.CrearHashConFuncion[punto, int](igualdadPuntos, hashes[j])
+		for i := 0; i < n; i++ {
+			dic.Guardar(punto{i, i}, i)
+		}
+		b.Run(nombre, func(b *testing.B) {
+			b.ReportAllocs()
 			for i := 0; i < b.N; i++ {
-				ejecutarPruebaVolumen(b, n)
+				dic.Obtener(punto{i % n, i % n})
 			}
 		})
 	}
 }
 
+func BenchmarkHashAltaCarga(b *testing.B) {
+	b.Log("Compara el hash cerrado con sondeo lineal contra el Robin Hood con las mismas claves y la misma " +
+		"capacidad, a factores de carga por encima de FACTOR_CARGA_MAX. Mide buscar claves que están y claves " +
+		"que no, e informa cuántas celdas revisa en promedio cada tipo de búsqueda")
+	const n = 50000
+	for _, carga := range []float64{0.8, 0.9} {
+		opciones := TDADiccionario.OpcionesHash[int]{ClavesEsperadas: n, FactorCargaMax: carga}
+		for _, impl := range []string{"Cerrado", "Robin Hood"} {
+			var dic TDADiccionario.Diccionario[int, int]
+			if impl == "Robin Hood" {
+				dic = TDADiccionario.CrearHashRobinHoodConOpciones[int, int](igualdadInts, opciones)
+			} else {
+				dic = TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, opciones)
+			}
+			for i := 0; i < n; i++ {
+				dic.Guardar(i, i)
+			}
+			est := dic.(TDADiccionario.ConEstadisticas).Estadisticas()
+
+			b.Run(fmt.Sprintf("%s carga %.1f/Encontradas", impl, carga), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					dic.Obtener(i % n)
+				}
+				b.ReportMetric(est.FactorCarga, "carga")
+				b.ReportMetric(est.SondeoPromedio, "sondeo-prom")
+				b.ReportMetric(float64(est.SondeoMaximo), "sondeo-max")
+			})
+			b.Run(fmt.Sprintf("%s carga %.1f/No encontradas", impl, carga), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					dic.Pertenece(-i - 1)
+				}
+				b.ReportMetric(est.FactorCarga, "carga")
+				b.ReportMetric(est.SondeoFallidoPromedio, "sondeo-prom")
+			})
+		}
+	}
+}
+
+func BenchmarkCargaMasiva(b *testing.B) {
+	b.Log("Compara cargar muchas claves en un hash que va redimensionando contra uno que reservó lugar de antemano")
+	const n = 1000000
+	b.Run("Sin reservar", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			dic := TDADiccionario.CrearHash[int, int](igualdadInts)
+			for j := 0; j < n; j++ {
+				dic.Guardar(j, j)
+			}
+		}
+	})
+	b.Run("Reservando", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			dic := TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+				ClavesEsperadas: n,
+			})
+			for j := 0; j < n; j++ {
+				dic.Guardar(j, j)
+			}
+		}
+	})
+}
+
+func BenchmarkLatenciaGuardar(b *testing.B) {
+	b.Log("Mide el Guardar más lento al cargar muchas claves, con redimensión de una vez y con redimensión " +
+		"incremental")
+	const n = 1000000
+	for _, incremental := range []bool{false, true} {
+		b.Run(fmt.Sprintf("Incremental %v", incremental), func(b *testing.B) {
+			var peor time.Duration
+			for i := 0; i < b.N; i++ {
+				dic := TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+					RedimensionIncremental: incremental,
+				})
+				for j := 0; j < n; j++ {
+					inicio := time.Now()
+					dic.Guardar(j, j)
+					if duracion := time.Since(inicio); duracion > peor {
+						peor = duracion
+					}
+				}
+			}
+			b.ReportMetric(float64(peor.Microseconds()), "us-peor-guardar")
+		})
+	}
+}
+
+func BenchmarkRedimensionIncremental(b *testing.B) {
+	b.Log("Compara el tiempo total de cargar muchas claves con y sin redimensión incremental. Migrar de a poco " +
+		"no debería hacer mucho más lentas las búsquedas en la tabla vieja, así que falla si la carga " +
+		"incremental tarda más del triple")
+	const n = 300000
+	cargar := func(incremental bool) time.Duration {
+		inicio := time.Now()
+		dic := TDADiccionario.CrearHashConOpciones[int, int](igualdadInts, TDADiccionario.OpcionesHash[int]{
+			RedimensionIncremental: incremental,
+		})
+		for j := 0; j < n; j++ {
+			dic.Guardar(j, j)
+		}
+		for j := 0; j < n; j++ {
+			dic.Pertenece(-j - 1)
+		}
+		return time.Since(inicio)
+	}
+	var normal, incremental time.Duration
+	for i := 0; i < b.N; i++ {
+		normal += cargar(false)
+		incremental += cargar(true)
+	}
+	proporcion := float64(incremental) / float64(normal)
+	b.ReportMetric(proporcion, "incremental/normal")
+	if proporcion > 3 {
+		b.Fatalf("La carga incremental tardo %v contra %v sin redimension incremental", incremental, normal)
+	}
+}
+
 func TestIterarDiccionarioVacio(t *testing.T) {
 	t.Log("Iterar sobre diccionario vacio es simplemente tenerlo al final")
-	dic := TDADiccionario.CrearHash[string, int](igualdadStrings)
-	iter := dic.Iterador()
-	require.False(t, iter.HaySiguiente())
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, int](impl, igualdadStrings)
+		iter := dic.Iterador()
+		require.False(t, iter.HaySiguiente())
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+	})
 }
 
 func TestDiccionarioIterar(t *testing.T) {
 	t.Log("Guardamos 3 valores en un Diccionario, e iteramos validando que las claves sean todas diferentes " +
 		"pero pertenecientes al diccionario. Además los valores de VerActual y Siguiente van siendo correctos entre sí")
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	valor1 := "miau"
-	valor2 := "guau"
-	valor3 := "moo"
-	claves := []string{clave1, clave2, clave3}
-	valores := []string{valor1, valor2, valor3}
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	dic.Guardar(claves[0], valores[0])
-	dic.Guardar(claves[1], valores[1])
-	dic.Guardar(claves[2], valores[2])
-	iter := dic.Iterador()
-
-	require.True(t, iter.HaySiguiente())
-	primero, _ := iter.VerActual()
-	require.NotEqualValues(t, -1, buscar(primero, claves))
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		clave1 := "Gato"
+		clave2 := "Perro"
+		clave3 := "Vaca"
+		valor1 := "miau"
+		valor2 := "guau"
+		valor3 := "moo"
+		claves := []string{clave1, clave2, clave3}
+		valores := []string{valor1, valor2, valor3}
+		dic := crearHash[string, string](impl, igualdadStrings)
+		dic.Guardar(claves[0], valores[0])
+		dic.Guardar(claves[1], valores[1])
+		dic.Guardar(claves[2], valores[2])
+		iter := dic.Iterador()
+
+		require.True(t, iter.HaySiguiente())
+		primero, _ := iter.VerActual()
+		require.NotEqualValues(t, -1, buscar(primero, claves))
 
-	iter.Siguiente()
-	segundo, segundo_valor := iter.VerActual()
-	require.NotEqualValues(t, -1, buscar(segundo, claves))
-	require.EqualValues(t, valores[buscar(segundo, claves)], segundo_valor)
-	require.NotEqualValues(t, primero, segundo)
-	require.True(t, iter.HaySiguiente())
+		iter.Siguiente()
+		segundo, segundo_valor := iter.VerActual()
+		require.NotEqualValues(t, -1, buscar(segundo, claves))
+		require.EqualValues(t, valores[buscar(segundo, claves)], segundo_valor)
+		require.NotEqualValues(t, primero, segundo)
+		require.True(t, iter.HaySiguiente())
 
-	iter.Siguiente()
-	require.True(t, iter.HaySiguiente())
-	tercero, _ := iter.VerActual()
-	require.NotEqualValues(t, -1, buscar(tercero, claves))
-	require.NotEqualValues(t, primero, tercero)
-	require.NotEqualValues(t, segundo, tercero)
-	iter.Siguiente()
+		iter.Siguiente()
+		require.True(t, iter.HaySiguiente())
+		tercero, _ := iter.VerActual()
+		require.NotEqualValues(t, -1, buscar(tercero, claves))
+		require.NotEqualValues(t, primero, tercero)
+		require.NotEqualValues(t, segundo, tercero)
+		iter.Siguiente()
 
-	require.False(t, iter.HaySiguiente())
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+		require.False(t, iter.HaySiguiente())
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+	})
 }
 
 func TestIteradorNoLlegaAlFinal(t *testing.T) {
 	t.Log("Crea un iterador y no lo avanza. Luego crea otro iterador y lo avanza.")
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	claves := []string{"A", "B", "C"}
-	dic.Guardar(claves[0], "")
-	dic.Guardar(claves[1], "")
-	dic.Guardar(claves[2], "")
-
-	dic.Iterador()
-	iter2 := dic.Iterador()
-	iter2.Siguiente()
-	iter3 := dic.Iterador()
-	primero, _ := iter3.VerActual()
-	iter3.Siguiente()
-	segundo, _ := iter3.VerActual()
-	iter3.Siguiente()
-	tercero, _ := iter3.VerActual()
-	iter3.Siguiente()
-	require.False(t, iter3.HaySiguiente())
-	require.NotEqualValues(t, primero, segundo)
-	require.NotEqualValues(t, tercero, segundo)
-	require.NotEqualValues(t, primero, tercero)
-	require.NotEqualValues(t, -1, buscar(primero, claves))
-	require.NotEqualValues(t, -1, buscar(segundo, claves))
-	require.NotEqualValues(t, -1, buscar(tercero, claves))
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, string](impl, igualdadStrings)
+		claves := []string{"A", "B", "C"}
+		dic.Guardar(claves[0], "")
+		dic.Guardar(claves[1], "")
+		dic.Guardar(claves[2], "")
+
+		dic.Iterador()
+		iter2 := dic.Iterador()
+		iter2.Siguiente()
+		iter3 := dic.Iterador()
+		primero, _ := iter3.VerActual()
+		iter3.Siguiente()
+		segundo, _ := iter3.VerActual()
+		iter3.Siguiente()
+		tercero, _ := iter3.VerActual()
+		iter3.Siguiente()
+		require.False(t, iter3.HaySiguiente())
+		require.NotEqualValues(t, primero, segundo)
+		require.NotEqualValues(t, tercero, segundo)
+		require.NotEqualValues(t, primero, tercero)
+		require.NotEqualValues(t, -1, buscar(primero, claves))
+		require.NotEqualValues(t, -1, buscar(segundo, claves))
+		require.NotEqualValues(t, -1, buscar(tercero, claves))
+	})
 }
 
 func TestPruebaIterarTrasBorrados(t *testing.T) {
 	t.Log("Prueba de caja blanca: Esta prueba intenta verificar el comportamiento del hash abierto cuando " +
 		"queda con listas vacías en su tabla. El iterador debería ignorar las listas vacías, avanzando hasta " +
 		"encontrar un elemento real.")
+	paraCadaHash(t, func(t *testing.T, impl string) {
+
+		clave1 := "Gato"
+		clave2 := "Perro"
+		clave3 := "Vaca"
+
+		dic := crearHash[string, string](impl, igualdadStrings)
+		dic.Guardar(clave1, "")
+		dic.Guardar(clave2, "")
+		dic.Guardar(clave3, "")
+		dic.Borrar(clave1)
+		dic.Borrar(clave2)
+		dic.Borrar(clave3)
+		iter := dic.Iterador()
+
+		require.False(t, iter.HaySiguiente())
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+		require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+		dic.Guardar(clave1, "A")
+		iter = dic.Iterador()
+
+		require.True(t, iter.HaySiguiente())
+		c1, v1 := iter.VerActual()
+		require.EqualValues(t, clave1, c1)
+		require.EqualValues(t, "A", v1)
+		iter.Siguiente()
+		require.False(t, iter.HaySiguiente())
+	})
+}
 
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-
-	dic := TDADiccionario.CrearHash[string, string](igualdadStrings)
-	dic.Guardar(clave1, "")
-	dic.Guardar(clave2, "")
-	dic.Guardar(clave3, "")
-	dic.Borrar(clave1)
-	dic.Borrar(clave2)
-	dic.Borrar(clave3)
-	iter := dic.Iterador()
-
-	require.False(t, iter.HaySiguiente())
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
-	dic.Guardar(clave1, "A")
-	iter = dic.Iterador()
-
-	require.True(t, iter.HaySiguiente())
-	c1, v1 := iter.VerActual()
-	require.EqualValues(t, clave1, c1)
-	require.EqualValues(t, "A", v1)
-	iter.Siguiente()
-	require.False(t, iter.HaySiguiente())
+func TestIteradorDiccionarioModificado(t *testing.T) {
+	t.Log("Si se guarda una clave nueva o se borra una mientras hay un iterador en uso, el iterador entra en " +
+		"pánico. Actualizar el dato de una clave existente no invalida al iterador")
+	paraCadaHash(t, func(t *testing.T, impl string) {
+		dic := crearHash[string, int](impl, igualdadStrings)
+		dic.Guardar("A", 1)
+		dic.Guardar("B", 2)
+
+		iter := dic.Iterador()
+		dic.Guardar("A", 10)
+		clave, _ := iter.VerActual()
+		require.NotEqualValues(t, -1, buscar(clave, []string{"A", "B"}))
+
+		dic.Guardar("C", 3)
+		require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+		require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.Siguiente() })
+
+		iter = dic.Iterador()
+		dic.Borrar("B")
+		require.PanicsWithValue(t, "El diccionario fue modificado durante la iteracion", func() { iter.VerActual() })
+	})
 }
 
-func ejecutarPruebasVolumenIterador(b *testing.B, n int) {
-	dic := TDADiccionario.CrearHash[string, *int](igualdadStrings)
+func ejecutarPruebasVolumenIterador(b *testing.B, impl string, n int) {
+	dic := crearHash[string, *int](impl, igualdadStrings)
 
 	claves := make([]string, n)
 	valores := make([]int, n)
@@ -623,42 +1518,46 @@ func BenchmarkIterador(b *testing.B) {
 	b.Log("Prueba de stress del Iterador del Diccionario. Prueba guardando distinta cantidad de elementos " +
 		"(muy grandes) b.N elementos, iterarlos todos sin problemas. Se ejecuta cada prueba b.N veces para generar " +
 		"un benchmark")
-	for _, n := range TAMS_VOLUMEN {
-		b.Run(fmt.Sprintf("Prueba %d elementos", n), func(b *testing.B) {
-			for i := 0; i < b.N; i++ {
-				ejecutarPruebasVolumenIterador(b, n)
-			}
-		})
+	for _, impl := range IMPLEMENTACIONES_HASH {
+		for _, n := range TAMS_VOLUMEN {
+			b.Run(fmt.Sprintf("%s %d elementos", impl, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					ejecutarPruebasVolumenIterador(b, impl, n)
+				}
+			})
+		}
 	}
 }
 
 func TestVolumenIteradorCorte(t *testing.T) {
 	t.Log("Prueba de volumen de iterador interno, para validar que siempre que se indique que se corte" +
 		" la iteración con la función visitar, se corte")
+	paraCadaHash(t, func(t *testing.T, impl string) {
 
-	dic := TDADiccionario.CrearHash[int, int](igualdadInts)
+		dic := crearHash[int, int](impl, igualdadInts)
 
-	/* Inserta 'n' parejas en el hash */
-	for i := 0; i < 10000; i++ {
-		dic.Guardar(i, i)
-	}
+		/* Inserta 'n' parejas en el hash */
+		for i := 0; i < 10000; i++ {
+			dic.Guardar(i, i)
+		}
 
-	seguirEjecutando := true
-	siguioEjecutandoCuandoNoDebia := false
+		seguirEjecutando := true
+		siguioEjecutandoCuandoNoDebia := false
 
-	dic.Iterar(func(c int, v int) bool {
-		if !seguirEjecutando {
-			siguioEjecutandoCuandoNoDebia = true
-			return false
-		}
-		if c%100 == 0 {
-			seguirEjecutando = false
-			return false
-		}
-		return true
-	})
+		dic.Iterar(func(c int, v int) bool {
+			if !seguirEjecutando {
+				siguioEjecutandoCuandoNoDebia = true
+				return false
+			}
+			if c%100 == 0 {
+				seguirEjecutando = false
+				return false
+			}
+			return true
+		})
 
-	require.False(t, seguirEjecutando, "Se tendría que haber encontrado un elemento que genere el corte")
-	require.False(t, siguioEjecutandoCuandoNoDebia,
-		"No debería haber seguido ejecutando si encontramos un elemento que hizo que la iteración corte")
+		require.False(t, seguirEjecutando, "Se tendría que haber encontrado un elemento que genere el corte")
+		require.False(t, siguioEjecutandoCuandoNoDebia,
+			"No debería haber seguido ejecutando si encontramos un elemento que hizo que la iteración corte")
+	})
 }
@@ -0,0 +1,143 @@
+package diccionario
+
+// EstadisticasHash describe el estado interno de un hash cerrado, para detectar funciones de hash que
+// distribuyen mal las claves.
+type EstadisticasHash struct {
+	Capacidad int
+	Cantidad  int
+	Borrados  int
+
+	// FactorCarga es la proporción de celdas no vacías (ocupadas o borradas), la que se usa para redimensionar
+	FactorCarga float64
+
+	// SondeoMaximo y SondeoPromedio miden cuántas celdas hay que revisar para encontrar cada clave guardada
+	SondeoMaximo   int
+	SondeoPromedio float64
+
+	// SondeoFallidoPromedio mide cuántas celdas hay que revisar para decidir que una clave no está, suponiendo
+	// que su posición inicial puede ser cualquiera con la misma probabilidad
+	SondeoFallidoPromedio float64
+
+	// Clusters indica, para cada tamaño, cuántas secuencias maximales de celdas no vacías hay de ese tamaño
+	Clusters map[int]int
+
+	// PendientesDeMigrar es la cantidad de claves que siguen en la tabla vieja durante una redimensión
+	// incremental. Los sondeos y clusters sólo describen a la tabla nueva.
+	PendientesDeMigrar int
+}
+
+// ConEstadisticas lo implementan los hashes que pueden informar sus EstadisticasHash
+type ConEstadisticas interface {
+	Estadisticas() EstadisticasHash
+}
+
+// sondeos devuelve la cantidad de celdas que se revisan para llegar de la posición inicial a la posición pos
+func sondeos(inicial, pos, capacidad int) int {
+	return (pos-inicial+capacidad)%capacidad + 1
+}
+
+// histogramaClusters recorre la tabla circular una sola vez, empezando después de una celda vacía para no
+// cortar en dos al cluster que da la vuelta
+func histogramaClusters(capacidad int, noVacia func(int) bool) map[int]int {
+	clusters := make(map[int]int)
+	inicio := -1
+	for i := 0; i < capacidad; i++ {
+		if !noVacia(i) {
+			inicio = i
+			break
+		}
+	}
+	if inicio == -1 {
+		clusters[capacidad] = 1
+		return clusters
+	}
+
+	largo := 0
+	for j := 1; j <= capacidad; j++ {
+		if noVacia((inicio + j) % capacidad) {
+			largo++
+		} else if largo > 0 {
+			clusters[largo]++
+			largo = 0
+		}
+	}
+	return clusters
+}
+
+func (h *hashCerrado[K, V]) Estadisticas() EstadisticasHash {
+	est := EstadisticasHash{
+		Capacidad:          h.capacidad,
+		Cantidad:           h.cantidad,
+		Borrados:           h.borrados,
+		FactorCarga:        h.factorCarga(),
+		PendientesDeMigrar: h.cantidadVieja,
+	}
+
+	total, claves := 0, 0
+	for pos, c := range h.tabla {
+		if c.estado != OCUPADO {
+			continue
+		}
+		s := sondeos(h.posicionInicial(c.clave, h.capacidad), pos, h.capacidad)
+		total += s
+		claves++
+		if s > est.SondeoMaximo {
+			est.SondeoMaximo = s
+		}
+	}
+	if claves > 0 {
+		est.SondeoPromedio = float64(total) / float64(claves)
+	}
+
+	est.Clusters = histogramaClusters(h.capacidad, func(i int) bool { return h.tabla[i].estado != VACIO })
+	est.SondeoFallidoPromedio = sondeoFallidoLineal(h.capacidad, est.Clusters)
+	return est
+}
+
+// sondeoFallidoLineal calcula el sondeo fallido promedio del sondeo lineal a partir de los clusters: una
+// búsqueda que empieza en la j-ésima celda desde el final de un cluster de largo L revisa j celdas ocupadas y
+// la vacía que le sigue, y una que empieza en una celda vacía revisa sólo esa
+func sondeoFallidoLineal(capacidad int, clusters map[int]int) float64 {
+	total := capacidad
+	for largo, cantidad := range clusters {
+		total += cantidad * largo * (largo + 1) / 2
+	}
+	return float64(total) / float64(capacidad)
+}
+
+func (h *hashRobinHood[K, V]) Estadisticas() EstadisticasHash {
+	est := EstadisticasHash{
+		Capacidad:   h.capacidad,
+		Cantidad:    h.cantidad,
+		FactorCarga: float64(h.cantidad) / float64(h.capacidad),
+	}
+
+	total := 0
+	for _, c := range h.tabla {
+		if !c.ocupada {
+			continue
+		}
+		total += c.distancia + 1
+		if c.distancia+1 > est.SondeoMaximo {
+			est.SondeoMaximo = c.distancia + 1
+		}
+	}
+	if h.cantidad > 0 {
+		est.SondeoPromedio = float64(total) / float64(h.cantidad)
+	}
+
+	est.Clusters = histogramaClusters(h.capacidad, func(i int) bool { return h.tabla[i].ocupada })
+
+	// Una búsqueda fallida corta en la primera celda vacía o más cerca de su posición que lo que se avanzó
+	fallidos := 0
+	for inicial := range h.tabla {
+		pos, distancia := inicial, 0
+		for h.tabla[pos].ocupada && h.tabla[pos].distancia >= distancia {
+			pos = h.siguientePosicion(pos)
+			distancia++
+		}
+		fallidos += distancia + 1
+	}
+	est.SondeoFallidoPromedio = float64(fallidos) / float64(h.capacidad)
+	return est
+}
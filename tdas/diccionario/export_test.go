@@ -0,0 +1,120 @@
+package diccionario
+
+import "fmt"
+
+// CrearABBDegenerado crea un ABB con las claves 0..n-1 en el que cada nodo es el hijo derecho del anterior,
+// como el que queda al guardar las claves en orden, pero en tiempo lineal
+func CrearABBDegenerado(n int) DiccionarioOrdenado[int, int] {
+	var raiz *nodoAbb[int, int]
+	for clave := n - 1; clave >= 0; clave-- {
+		raiz = &nodoAbb[int, int]{clave: clave, dato: clave, derecho: raiz, tamanio: n - clave}
+	}
+	return &abb[int, int]{raiz: raiz, cantidad: n, cmp: func(a, b int) int { return a - b }}
+}
+
+// VerificarAVL expone a las pruebas la validación de los invariantes del AVL
+func VerificarAVL[K any, V any](dic DiccionarioOrdenado[K, V]) error {
+	return dic.(*avl[K, V]).verificarInvariantes()
+}
+
+// verificarInvariantes devuelve un error si el árbol no es un ABB, si algún nodo tiene mal su tamaño o su
+// altura, o si los subárboles de algún nodo difieren en más de uno de altura
+func (a *avl[K, V]) verificarInvariantes() error {
+	cantidad, err := a.verificarSubarbol(a.raiz, nil, nil)
+	if err != nil {
+		return err
+	}
+	if cantidad != a.cantidad {
+		return fmt.Errorf("el arbol tiene %d nodos pero la cantidad es %d", cantidad, a.cantidad)
+	}
+	return nil
+}
+
+// verificarSubarbol valida que las claves del subárbol estén entre desde y hasta (sin incluirlos), y devuelve
+// su cantidad de nodos
+func (a *avl[K, V]) verificarSubarbol(nodo *nodoAbb[K, V], desde, hasta *K) (int, error) {
+	if nodo == nil {
+		return 0, nil
+	}
+	if (desde != nil && a.cmp(nodo.clave, *desde) <= 0) || (hasta != nil && a.cmp(nodo.clave, *hasta) >= 0) {
+		return 0, fmt.Errorf("la clave %v no respeta el orden del ABB", nodo.clave)
+	}
+	cantIzq, err := a.verificarSubarbol(nodo.izquierdo, desde, &nodo.clave)
+	if err != nil {
+		return 0, err
+	}
+	cantDer, err := a.verificarSubarbol(nodo.derecho, &nodo.clave, hasta)
+	if err != nil {
+		return 0, err
+	}
+	if nodo.altura != max(altura(nodo.izquierdo), altura(nodo.derecho))+1 {
+		return 0, fmt.Errorf("el nodo %v tiene mal su altura", nodo.clave)
+	}
+	if balance := factorBalance(nodo); balance > 1 || balance < -1 {
+		return 0, fmt.Errorf("el nodo %v tiene factor de balance %d", nodo.clave, balance)
+	}
+	if nodo.tamanio != cantIzq+cantDer+1 {
+		return 0, fmt.Errorf("el tamaño guardado en %v no coincide con el de su subárbol", nodo.clave)
+	}
+	return cantIzq + cantDer + 1, nil
+}
+
+// VerificarRojoNegro expone a las pruebas la validación de los invariantes del árbol rojo-negro
+func VerificarRojoNegro[K any, V any](dic DiccionarioOrdenado[K, V]) error {
+	return dic.(*arbolRojoNegro[K, V]).verificarInvariantes()
+}
+
+// verificarInvariantes devuelve un error si el árbol no es un ABB, si algún nodo tiene mal su tamaño, o si no
+// cumple alguna de las propiedades de los árboles rojo-negro
+func (a *arbolRojoNegro[K, V]) verificarInvariantes() error {
+	if esRojo(a.raiz) {
+		return fmt.Errorf("la raiz es roja")
+	}
+	cantidad, _, err := a.verificarSubarbol(a.raiz, nil, nil)
+	if err != nil {
+		return err
+	}
+	if cantidad != a.cantidad {
+		return fmt.Errorf("el arbol tiene %d nodos pero la cantidad es %d", cantidad, a.cantidad)
+	}
+	return nil
+}
+
+// verificarSubarbol valida que las claves del subárbol estén entre desde y hasta (sin incluirlos), y devuelve su cantidad de nodos
+// y su altura negra
+func (a *arbolRojoNegro[K, V]) verificarSubarbol(nodo *nodoAbb[K, V], desde, hasta *K) (int, int, error) {
+	if nodo == nil {
+		return 0, 1, nil
+	}
+	if (desde != nil && a.cmp(nodo.clave, *desde) <= 0) || (hasta != nil && a.cmp(nodo.clave, *hasta) >= 0) {
+		return 0, 0, fmt.Errorf("la clave %v no respeta el orden del ABB", nodo.clave)
+	}
+	if esRojo(nodo.derecho) {
+		return 0, 0, fmt.Errorf("el nodo %v tiene un hijo derecho rojo", nodo.clave)
+	}
+	if nodo.rojo && esRojo(nodo.izquierdo) {
+		return 0, 0, fmt.Errorf("el nodo rojo %v tiene un hijo rojo", nodo.clave)
+	}
+
+	cantIzq, negrosIzq, err := a.verificarSubarbol(nodo.izquierdo, desde, &nodo.clave)
+	if err != nil {
+		return 0, 0, err
+	}
+	cantDer, negrosDer, err := a.verificarSubarbol(nodo.derecho, &nodo.clave, hasta)
+	if err != nil {
+		return 0, 0, err
+	}
+	if negrosIzq != negrosDer {
+		return 0, 0, fmt.Errorf("los caminos bajo %v tienen distinta cantidad de nodos negros", nodo.clave)
+	}
+
+	if nodo.tamanio != cantIzq+cantDer+1 {
+		return 0, 0, fmt.Errorf("el tamaño guardado en %v no coincide con el de su subárbol", nodo.clave)
+	}
+
+	negros := negrosIzq
+	if !nodo.rojo {
+		negros++
+	}
+	return cantIzq + cantDer + 1, negros, nil
+}
@@ -0,0 +1,148 @@
+package diccionario
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	TDAPila "tdas/pila"
+)
+
+// BalanceABB describe la forma de un árbol, para detectar si quedó desbalanceado
+type BalanceABB[K any] struct {
+	// Altura es la cantidad de niveles del árbol: 0 si está vacío, 1 si sólo tiene la raíz
+	Altura int
+
+	// ProfundidadMinimaHoja y ProfundidadMaximaHoja indican a qué distancia de la raíz (que está a profundidad 0)
+	// están la hoja más cercana y la más lejana. En un árbol balanceado difieren en poco.
+	ProfundidadMinimaHoja int
+	ProfundidadMaximaHoja int
+
+	// Subarboles tiene, en el orden de las claves, el tamaño del subárbol de cada nodo
+	Subarboles []SubarbolABB[K]
+}
+
+// SubarbolABB describe el subárbol que tiene como raíz al nodo de la clave indicada
+type SubarbolABB[K any] struct {
+	Clave            K
+	Profundidad      int
+	Tamanio          int
+	TamanioIzquierdo int
+	TamanioDerecho   int
+}
+
+// ConFormaABB lo implementan los árboles que pueden informar su forma
+type ConFormaABB[K any] interface {
+	// Altura devuelve la cantidad de niveles del árbol
+	Altura() int
+
+	// Balance devuelve la altura, las profundidades de las hojas y el tamaño de cada subárbol
+	Balance() BalanceABB[K]
+
+	// ExportarDOT escribe el árbol en el formato DOT de Graphviz, mostrando cada clave con formatear. Los nodos
+	// rojos de un árbol rojo-negro se dibujan en rojo.
+	ExportarDOT(w io.Writer, formatear func(K) string) error
+}
+
+type nodoConProfundidad[K any, V any] struct {
+	nodo        *nodoAbb[K, V]
+	profundidad int
+}
+
+// recorrerConProfundidad visita todos los nodos en preorder junto con su profundidad
+func (a *abb[K, V]) recorrerConProfundidad(visitar func(nodo *nodoAbb[K, V], profundidad int)) {
+	if a.raiz == nil {
+		return
+	}
+	pila := TDAPila.CrearPilaDinamica[nodoConProfundidad[K, V]]()
+	pila.Apilar(nodoConProfundidad[K, V]{a.raiz, 0})
+	for !pila.EstaVacia() {
+		actual := pila.Desapilar()
+		visitar(actual.nodo, actual.profundidad)
+		if actual.nodo.derecho != nil {
+			pila.Apilar(nodoConProfundidad[K, V]{actual.nodo.derecho, actual.profundidad + 1})
+		}
+		if actual.nodo.izquierdo != nil {
+			pila.Apilar(nodoConProfundidad[K, V]{actual.nodo.izquierdo, actual.profundidad + 1})
+		}
+	}
+}
+
+func (a *abb[K, V]) Altura() int {
+	altura := 0
+	a.recorrerConProfundidad(func(_ *nodoAbb[K, V], profundidad int) {
+		altura = max(altura, profundidad+1)
+	})
+	return altura
+}
+
+func (a *abb[K, V]) Balance() BalanceABB[K] {
+	balance := BalanceABB[K]{Subarboles: make([]SubarbolABB[K], 0, a.cantidad)}
+	if a.raiz == nil {
+		return balance
+	}
+
+	balance.ProfundidadMinimaHoja = a.cantidad
+	profundidades := make(map[*nodoAbb[K, V]]int, a.cantidad)
+	a.recorrerConProfundidad(func(nodo *nodoAbb[K, V], profundidad int) {
+		profundidades[nodo] = profundidad
+		balance.Altura = max(balance.Altura, profundidad+1)
+		if nodo.izquierdo == nil && nodo.derecho == nil {
+			balance.ProfundidadMinimaHoja = min(balance.ProfundidadMinimaHoja, profundidad)
+			balance.ProfundidadMaximaHoja = max(balance.ProfundidadMaximaHoja, profundidad)
+		}
+	})
+
+	for _, nodo := range a.nodosEnOrden() {
+		balance.Subarboles = append(balance.Subarboles, SubarbolABB[K]{
+			Clave:            nodo.clave,
+			Profundidad:      profundidades[nodo],
+			Tamanio:          nodo.tamanio,
+			TamanioIzquierdo: tamanio(nodo.izquierdo),
+			TamanioDerecho:   tamanio(nodo.derecho),
+		})
+	}
+	return balance
+}
+
+func (a *abb[K, V]) ExportarDOT(w io.Writer, formatear func(K) string) error {
+	salida := bufio.NewWriter(w)
+	fmt.Fprintln(salida, "digraph ABB {")
+	fmt.Fprintln(salida, "\tnode [shape=circle];")
+
+	// Cada nodo se identifica por su posición en preorder. A los hijos que faltan se los dibuja como un punto
+	// para que un hijo único se vea a izquierda o derecha según corresponda.
+	ids := make(map[*nodoAbb[K, V]]int, a.cantidad)
+	vacios := 0
+	a.recorrerConProfundidad(func(nodo *nodoAbb[K, V], _ int) {
+		id := len(ids)
+		ids[nodo] = id
+		color := ""
+		if nodo.rojo {
+			color = ", color=red, fontcolor=red"
+		}
+		fmt.Fprintf(salida, "\tn%d [label=\"%s\"%s];\n", id, escaparDOT(formatear(nodo.clave)), color)
+	})
+	a.recorrerConProfundidad(func(nodo *nodoAbb[K, V], _ int) {
+		if nodo.izquierdo == nil && nodo.derecho == nil {
+			return
+		}
+		for _, hijo := range []*nodoAbb[K, V]{nodo.izquierdo, nodo.derecho} {
+			if hijo != nil {
+				fmt.Fprintf(salida, "\tn%d -> n%d;\n", ids[nodo], ids[hijo])
+			} else {
+				fmt.Fprintf(salida, "\tvacio%d [shape=point];\n\tn%d -> vacio%d;\n", vacios, ids[nodo], vacios)
+				vacios++
+			}
+		}
+	})
+
+	fmt.Fprintln(salida, "}")
+	return salida.Flush()
+}
+
+// escaparDOT escapa las comillas y barras de una etiqueta entre comillas
+func escaparDOT(etiqueta string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(etiqueta)
+}
@@ -0,0 +1,254 @@
+package diccionario
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math/bits"
+	"math/rand/v2"
+	"reflect"
+)
+
+// FuncionHash transforma una clave en un número de 32 bits. Dos claves iguales según la función de igualdad
+// del diccionario deben tener el mismo hash.
+type FuncionHash[K any] func(clave K) uint32
+
+// FuncionHashConSemilla es una FuncionHash que además recibe la semilla de la tabla, de forma que las
+// posiciones de las claves cambian de una tabla a otra. Eso hace más difícil preparar de antemano claves que
+// colisionen, pero no alcanza con cualquier función: MurmurHash3 tiene colisiones que se dan con todas las
+// semillas. Para claves que puede elegir un atacante conviene HashSipCadena o HashSipBytes.
+type FuncionHashConSemilla[K any] func(clave K, semilla uint64) uint32
+
+type entero interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// HashCadena aplica MurmurHash3 directamente sobre los bytes de la cadena, sin copiarla.
+func HashCadena[K ~string](clave K) uint32 {
+	return murmur3(clave, 0)
+}
+
+// HashBytes aplica MurmurHash3 sobre el slice de bytes.
+func HashBytes[K ~[]byte](clave K) uint32 {
+	return murmur3(clave, 0)
+}
+
+// HashEntero mezcla los bits del entero con el finalizador de MurmurHash3 de 64 bits, sin pasarlo a texto.
+func HashEntero[K entero](clave K) uint32 {
+	return hashEntero(clave, 0)
+}
+
+// HashGenerico sirve para cualquier tipo de clave: la formatea con %v y aplica MurmurHash3 sobre el texto.
+// Reserva memoria en cada llamado, y claves distintas con la misma representación colisionan siempre.
+func HashGenerico[K any](clave K) uint32 {
+	return murmur3(fmt.Sprintf("%v", clave), 0)
+}
+
+// HashSipCadena aplica SipHash-2-4 sobre la cadena usando la semilla como clave secreta. Es más lento que
+// MurmurHash3, pero sin conocer la semilla no se pueden fabricar claves que colisionen.
+func HashSipCadena[K ~string](clave K, semilla uint64) uint32 {
+	return plegar(sipHash24(clave, semilla, fmix64(semilla^0x9e3779b97f4a7c15)))
+}
+
+// HashSipBytes aplica SipHash-2-4 sobre el slice de bytes usando la semilla como clave secreta.
+func HashSipBytes[K ~[]byte](clave K, semilla uint64) uint32 {
+	return plegar(sipHash24(clave, semilla, fmix64(semilla^0x9e3779b97f4a7c15)))
+}
+
+func hashEntero[K entero](clave K, semilla uint64) uint32 {
+	return plegar(fmix64(uint64(clave) ^ semilla))
+}
+
+// plegar reduce un hash de 64 bits a 32 sin descartar la mitad alta
+func plegar(x uint64) uint32 {
+	return uint32(x) ^ uint32(x>>32)
+}
+
+// semillaAleatoria elige la semilla de una tabla nueva
+func semillaAleatoria() uint64 {
+	return rand.Uint64()
+}
+
+// conSemilla fija la semilla de una FuncionHashConSemilla
+func conSemilla[K any](hash FuncionHashConSemilla[K], semilla uint64) FuncionHash[K] {
+	return func(clave K) uint32 {
+		return hash(clave, semilla)
+	}
+}
+
+// semillaComparable se elige al azar una única vez por proceso, como la de los map de Go. maphash no permite
+// armar una semilla a partir de un número, así que la de cada tabla se combina con el resultado.
+var semillaComparable = maphash.MakeSeed()
+
+// HashComparable sirve para cualquier tipo comparable: usa el mismo hash que los map de Go, sin formatear la
+// clave. Es consistente con ==, por lo que dos punteros se consideran iguales sólo si apuntan a lo mismo.
+func HashComparable[K comparable](clave K) uint32 {
+	return HashComparableConSemilla(clave, 0)
+}
+
+// HashComparableConSemilla es HashComparable combinado con la semilla de la tabla. Dos tablas con la misma
+// semilla ubican igual las claves sólo dentro de un mismo proceso.
+func HashComparableConSemilla[K comparable](clave K, semilla uint64) uint32 {
+	return plegar(fmix64(maphash.Comparable(semillaComparable, clave) ^ semilla))
+}
+
+// hashPorDefecto elige el hash especializado para los tipos de clave más comunes. Los tipos definidos a partir
+// de cadenas, bytes o enteros se reconocen por reflexión; el resto (structs, punteros, etc.) se formatea como
+// texto.
+func hashPorDefecto[K any](clave K, semilla uint64) uint32 {
+	semilla32 := plegar(semilla)
+	switch c := any(clave).(type) {
+	case string:
+		return murmur3(c, semilla32)
+	case []byte:
+		return murmur3(c, semilla32)
+	case int:
+		return hashEntero(c, semilla)
+	case int64:
+		return hashEntero(c, semilla)
+	case int32:
+		return hashEntero(c, semilla)
+	case uint:
+		return hashEntero(c, semilla)
+	case uint64:
+		return hashEntero(c, semilla)
+	case uint32:
+		return hashEntero(c, semilla)
+	}
+
+	valor := reflect.ValueOf(clave)
+	switch valor.Kind() {
+	case reflect.String:
+		return murmur3(valor.String(), semilla32)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return hashEntero(valor.Int(), semilla)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return hashEntero(valor.Uint(), semilla)
+	case reflect.Slice:
+		if valor.Type().Elem().Kind() == reflect.Uint8 {
+			return murmur3(valor.Bytes(), semilla32)
+		}
+	}
+	return murmur3(fmt.Sprintf("%v", clave), semilla32)
+}
+
+// MurmurHash3 (32-bit)
+// Fuente original: https://github.com/aappleby/smhasher/blob/master/src/MurmurHash3.cpp
+func murmur3[B ~string | ~[]byte](bytes B, seed uint32) uint32 {
+	const (
+		c1 uint32 = 0xcc9e2d51
+		c2 uint32 = 0x1b873593
+		r1 uint32 = 15
+		r2 uint32 = 13
+		m  uint32 = 5
+		n  uint32 = 0xe6546b64
+	)
+
+	hash := seed
+	length := len(bytes)
+
+	nblocks := length / 4
+	for i := 0; i < nblocks; i++ {
+		k := uint32(bytes[i*4]) | uint32(bytes[i*4+1])<<8 |
+			uint32(bytes[i*4+2])<<16 | uint32(bytes[i*4+3])<<24
+
+		k *= c1
+		k = (k << r1) | (k >> (32 - r1))
+		k *= c2
+
+		hash ^= k
+		hash = (hash << r2) | (hash >> (32 - r2))
+		hash = hash*m + n
+	}
+
+	tail := nblocks * 4
+	var k1 uint32
+	switch length - tail {
+	case 3:
+		k1 ^= uint32(bytes[tail+2]) << 16
+		fallthrough
+	case 2:
+		k1 ^= uint32(bytes[tail+1]) << 8
+		fallthrough
+	case 1:
+		k1 ^= uint32(bytes[tail])
+		k1 *= c1
+		k1 = (k1 << r1) | (k1 >> (32 - r1))
+		k1 *= c2
+		hash ^= k1
+	}
+
+	hash ^= uint32(length)
+	hash ^= hash >> 16
+	hash *= 0x85ebca6b
+	hash ^= hash >> 13
+	hash *= 0xc2b2ae35
+	hash ^= hash >> 16
+
+	return hash
+}
+
+// fmix64 es el finalizador de 64 bits de MurmurHash3
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
+
+// SipHash-2-4
+// Fuente original: https://github.com/veorq/SipHash/blob/master/siphash.c
+func sipHash24[B ~string | ~[]byte](datos B, k0, k1 uint64) uint64 {
+	v0 := k0 ^ 0x736f6d6570736575
+	v1 := k1 ^ 0x646f72616e646f6d
+	v2 := k0 ^ 0x6c7967656e657261
+	v3 := k1 ^ 0x7465646279746573
+
+	ronda := func() {
+		v0 += v1
+		v1 = bits.RotateLeft64(v1, 13)
+		v1 ^= v0
+		v0 = bits.RotateLeft64(v0, 32)
+		v2 += v3
+		v3 = bits.RotateLeft64(v3, 16)
+		v3 ^= v2
+		v0 += v3
+		v3 = bits.RotateLeft64(v3, 21)
+		v3 ^= v0
+		v2 += v1
+		v1 = bits.RotateLeft64(v1, 17)
+		v1 ^= v2
+		v2 = bits.RotateLeft64(v2, 32)
+	}
+
+	length := len(datos)
+	nbloques := length / 8
+	for i := 0; i < nbloques; i++ {
+		var m uint64
+		for j := 7; j >= 0; j-- {
+			m = m<<8 | uint64(datos[i*8+j])
+		}
+		v3 ^= m
+		ronda()
+		ronda()
+		v0 ^= m
+	}
+
+	// El último bloque lleva los bytes que sobran y el largo en el byte más alto
+	m := uint64(length) << 56
+	for j := length - 1; j >= nbloques*8; j-- {
+		m |= uint64(datos[j]) << (8 * uint(j-nbloques*8))
+	}
+	v3 ^= m
+	ronda()
+	ronda()
+	v0 ^= m
+
+	v2 ^= 0xff
+	ronda()
+	ronda()
+	ronda()
+	ronda()
+	return v0 ^ v1 ^ v2 ^ v3
+}
@@ -1,18 +1,24 @@
 package diccionario
 
-import "fmt"
-
 type estadoCelda int
 
 const (
-	CAPACIDAD_INICIAL                  = 17
-	FACTOR_CARGA_MAX                   = 0.7
-	FACTOR_CARGA_MIN                   = 0.2
-	FACTOR_REDIMENSION                 = 2
-	CAPACIDAD_MINIMA                   = 17
-	MENSAJE_CLAVE_INEXIST              = "La clave no pertenece al diccionario"
-	MENSAJE_ITER_TERMINADO             = "El iterador termino de iterar"
-	VACIO                  estadoCelda = iota
+	CAPACIDAD_INICIAL          = 17
+	FACTOR_CARGA_MAX           = 0.7
+	FACTOR_CARGA_MIN           = 0.2
+	FACTOR_REDIMENSION         = 2
+	FACTOR_BORRADOS_MAX        = 0.25
+	PASOS_MIGRACION            = 16
+	CAPACIDAD_MINIMA           = 17
+	MENSAJE_CLAVE_INEXIST      = "La clave no pertenece al diccionario"
+	MENSAJE_ITER_TERMINADO     = "El iterador termino de iterar"
+	MENSAJE_DICC_MODIFICADO    = "El diccionario fue modificado durante la iteracion"
+	MENSAJE_OPCIONES_INVALIDAS = "Las opciones del hash son invalidas"
+)
+
+// VACIO es el valor cero, así una tabla recién creada tiene todas sus celdas vacías
+const (
+	VACIO estadoCelda = iota
 	OCUPADO
 	BORRADO
 )
@@ -24,144 +30,309 @@ type celda[K any, V any] struct {
 }
 
 type hashCerrado[K any, V any] struct {
-	tabla     []*celda[K, V]
-	capacidad int
-	cantidad  int
-	borrados  int
-	igualdad  func(K, K) bool
+	tabla          []celda[K, V]
+	capacidad      int
+	cantidad       int
+	borrados       int
+	igualdad       func(K, K) bool
+	hash           FuncionHash[K]
+	modificaciones int
+
+	capacidadMinima   int
+	factorCargaMax    float64
+	factorCargaMin    float64
+	factorRedimension float64
+	achicar           bool
+
+	// Redimensión incremental: mientras tablaVieja no es nil, sus claves se van pasando a tabla de a (al menos)
+	// PASOS_MIGRACION posiciones en cada Guardar o Borrar. migrados es la primera posición sin pasar, y
+	// cantidadVieja cuántas claves (de las contadas en cantidad) quedan en tablaVieja.
+	incremental   bool
+	tablaVieja    []celda[K, V]
+	migrados      int
+	cantidadVieja int
+}
+
+// OpcionesHash permite configurar un hash cerrado al crearlo. Los campos en su valor cero toman el valor por
+// defecto.
+type OpcionesHash[K any] struct {
+	// Hash es la función con la que se ubican las claves. Al no recibir semilla, la tabla no puede variarla, por
+	// lo que es responsabilidad de quien la provee que no se puedan fabricar colisiones. Por defecto se elige
+	// una función según el tipo de clave.
+	Hash FuncionHash[K]
+
+	// HashConSemilla es una alternativa a Hash que recibe la semilla de la tabla, como HashSipCadena. No se
+	// puede indicar junto con Hash.
+	HashConSemilla FuncionHashConSemilla[K]
+
+	// Semilla fija la semilla de la tabla, por ejemplo para pruebas que necesitan que las claves queden siempre
+	// en las mismas posiciones. Por defecto (0) cada tabla elige una al azar.
+	Semilla uint64
+
+	// ClavesEsperadas reserva desde el principio lugar para esa cantidad de claves, para no redimensionar
+	// mientras se cargan. La tabla nunca se achica por debajo de esa capacidad.
+	ClavesEsperadas int
+
+	// FactorCargaMax, entre 0 y 1, es la proporción de celdas no vacías a partir de la cual la tabla se agranda.
+	// Por defecto FACTOR_CARGA_MAX.
+	FactorCargaMax float64
+
+	// FactorCargaMin es la proporción por debajo de la cual la tabla se achica. Multiplicado por
+	// FactorRedimension debe ser menor a FactorCargaMax, para que achicar la tabla no la deje tan llena que haya
+	// que agrandarla. Por defecto es FACTOR_CARGA_MIN, o si se cambiaron los otros factores, el valor que guarda
+	// con ellos la misma proporción.
+	FactorCargaMin float64
+
+	// FactorRedimension, mayor a 1, indica por cuánto se multiplica o divide la capacidad al redimensionar.
+	// Por defecto FACTOR_REDIMENSION.
+	FactorRedimension float64
+
+	// SinAchicar evita que la tabla se achique al borrar
+	SinAchicar bool
+
+	// RedimensionIncremental hace que, en lugar de pasar todas las claves a la tabla nueva en el Guardar o
+	// Borrar que dispara la redimensión, se conserven ambas tablas y las claves se vayan pasando de a poco en
+	// los Guardar y Borrar siguientes. Evita pausas largas en diccionarios grandes.
+	RedimensionIncremental bool
 }
 
 type iterHash[K any, V any] struct {
-	hash     *hashCerrado[K, V]
-	posicion int
+	hash           *hashCerrado[K, V]
+	posicion       int
+	modificaciones int
 }
 
-func convertirABytes[K any](clave K) []byte {
-	return []byte(fmt.Sprintf("%v", clave))
+// posicionInicial devuelve la posición de una tabla de esa capacidad donde empieza a buscarse la clave
+func (h *hashCerrado[K, V]) posicionInicial(clave K, capacidad int) int {
+	return int(h.hash(clave) % uint32(capacidad))
 }
 
-// MurmurHash3 (32-bit)
-// Fuente original: https://github.com/aappleby/smhasher/blob/master/src/MurmurHash3.cpp
-func funcionHash[K any](clave K, capacidad int) int {
-	bytes := convertirABytes(clave)
+func (h *hashCerrado[K, V]) crearTabla(capacidad int) {
+	h.tabla = make([]celda[K, V], capacidad)
+	h.capacidad = capacidad
+	h.cantidad = 0
+	h.borrados = 0
+}
 
-	const (
-		c1 uint32 = 0xcc9e2d51
-		c2 uint32 = 0x1b873593
-		r1 uint32 = 15
-		r2 uint32 = 13
-		m  uint32 = 5
-		n  uint32 = 0xe6546b64
-	)
+// CrearHash crea un hash cerrado vacío. Para claves string, []byte o enteros, o de tipos definidos a partir de
+// ellos, usa un hash especializado; para el resto, las formatea como texto. Cada tabla usa una semilla al azar.
+func CrearHash[K any, V any](igualdad func(K, K) bool) Diccionario[K, V] {
+	return CrearHashConOpciones[K, V](igualdad, OpcionesHash[K]{})
+}
 
-	seed := uint32(0)
-	hash := seed
-	length := len(bytes)
+// CrearHashComparable crea un hash cerrado vacío para claves comparables, que se comparan con == y se ubican
+// con HashComparableConSemilla, sin necesidad de pasar ninguna función
+func CrearHashComparable[K comparable, V any]() Diccionario[K, V] {
+	return CrearHashConOpciones[K, V](func(a, b K) bool { return a == b }, OpcionesHash[K]{
+		HashConSemilla: HashComparableConSemilla[K],
+	})
+}
 
-	nblocks := length / 4
-	for i := 0; i < nblocks; i++ {
-		k := uint32(bytes[i*4]) | uint32(bytes[i*4+1])<<8 |
-			uint32(bytes[i*4+2])<<16 | uint32(bytes[i*4+3])<<24
+// CrearHashConFuncion crea un hash cerrado vacío que ubica las claves con la función de hash indicada
+func CrearHashConFuncion[K any, V any](igualdad func(K, K) bool, hash FuncionHash[K]) Diccionario[K, V] {
+	return CrearHashConOpciones[K, V](igualdad, OpcionesHash[K]{Hash: hash})
+}
 
-		k *= c1
-		k = (k << r1) | (k >> (32 - r1))
-		k *= c2
+// CrearHashConOpciones crea un hash cerrado vacío configurado según las opciones. Si alguna opción está fuera
+// de rango, entra en pánico con un mensaje "Las opciones del hash son invalidas".
+func CrearHashConOpciones[K any, V any](igualdad func(K, K) bool, opciones OpcionesHash[K]) Diccionario[K, V] {
+	capacidad := completarOpciones(&opciones, FACTOR_CARGA_MAX)
+	h := &hashCerrado[K, V]{
+		igualdad:          igualdad,
+		hash:              opciones.Hash,
+		capacidadMinima:   capacidad,
+		factorCargaMax:    opciones.FactorCargaMax,
+		factorCargaMin:    opciones.FactorCargaMin,
+		factorRedimension: opciones.FactorRedimension,
+		achicar:           !opciones.SinAchicar,
+		incremental:       opciones.RedimensionIncremental,
+	}
+	h.crearTabla(capacidad)
+	return h
+}
 
-		hash ^= k
-		hash = (hash << r2) | (hash >> (32 - r2))
-		hash = hash*m + n
+// completarOpciones reemplaza las opciones en su valor cero por las de la implementación, cuyo factor de carga
+// máximo por defecto es factorCargaMax, y entra en pánico si alguna es inválida. Deja en Hash la función ya
+// combinada con la semilla, y devuelve la capacidad inicial de la tabla.
+func completarOpciones[K any](opciones *OpcionesHash[K], factorCargaMax float64) int {
+	if opciones.Hash != nil && opciones.HashConSemilla != nil {
+		panic(MENSAJE_OPCIONES_INVALIDAS)
+	}
+	if opciones.Semilla == 0 {
+		opciones.Semilla = semillaAleatoria()
+	}
+	if opciones.Hash == nil {
+		if opciones.HashConSemilla == nil {
+			opciones.HashConSemilla = hashPorDefecto[K]
+		}
+		opciones.Hash = conSemilla(opciones.HashConSemilla, opciones.Semilla)
+	}
+	if opciones.FactorCargaMax == 0 {
+		opciones.FactorCargaMax = factorCargaMax
+	}
+	if opciones.FactorRedimension == 0 {
+		opciones.FactorRedimension = FACTOR_REDIMENSION
+	}
+	if opciones.FactorCargaMin == 0 {
+		// Guarda con los otros dos factores la misma proporción que los valores por defecto entre sí
+		opciones.FactorCargaMin = opciones.FactorCargaMax / opciones.FactorRedimension *
+			(FACTOR_CARGA_MIN * FACTOR_REDIMENSION / factorCargaMax)
+	}
+	// Después de agrandar, el factor de carga queda en FactorCargaMax / FactorRedimension, y después de achicar
+	// en FactorCargaMin * FactorRedimension. Si no quedaran entre ambos mínimo y máximo, la tabla volvería a
+	// redimensionarse enseguida.
+	if opciones.ClavesEsperadas < 0 || opciones.FactorCargaMax < 0 || opciones.FactorCargaMax >= 1 ||
+		opciones.FactorRedimension <= 1 || opciones.FactorCargaMin < 0 ||
+		opciones.FactorCargaMin*opciones.FactorRedimension >= opciones.FactorCargaMax {
+		panic(MENSAJE_OPCIONES_INVALIDAS)
 	}
 
-	tail := bytes[nblocks*4:]
-	var k1 uint32
-	switch len(tail) {
-	case 3:
-		k1 ^= uint32(tail[2]) << 16
-		fallthrough
-	case 2:
-		k1 ^= uint32(tail[1]) << 8
-		fallthrough
-	case 1:
-		k1 ^= uint32(tail[0])
-		k1 *= c1
-		k1 = (k1 << r1) | (k1 >> (32 - r1))
-		k1 *= c2
-		hash ^= k1
+	capacidad := CAPACIDAD_INICIAL
+	if necesaria := int(float64(opciones.ClavesEsperadas)/opciones.FactorCargaMax) + 1; necesaria > capacidad {
+		capacidad = necesaria
 	}
+	return capacidad
+}
 
-	hash ^= uint32(length)
-	hash ^= hash >> 16
-	hash *= 0x85ebca6b
-	hash ^= hash >> 13
-	hash *= 0xc2b2ae35
-	hash ^= hash >> 16
+// factorCarga cuenta también las claves que faltan migrar, ya que van a terminar en la tabla nueva
+func (h *hashCerrado[K, V]) factorCarga() float64 {
+	return float64(h.cantidad+h.borrados) / float64(h.capacidad)
+}
 
-	return int(hash) % capacidad
+func (h *hashCerrado[K, V]) debeAgrandar() bool {
+	return h.factorCarga() > h.factorCargaMax
 }
 
-func (h *hashCerrado[K, V]) crearTabla(capacidad int) {
-	h.tabla = make([]*celda[K, V], capacidad)
-	h.capacidad = capacidad
-	h.cantidad = 0
-	h.borrados = 0
+// debeAchicar no cuenta las celdas borradas, que desaparecen al redimensionar: con pocas claves nunca llegarían
+// a ser tantas como para compactarlas, y mantendrían a la tabla grande
+func (h *hashCerrado[K, V]) debeAchicar() bool {
+	return h.achicar && h.capacidad > h.capacidadMinima &&
+		float64(h.cantidad)/float64(h.capacidad) < h.factorCargaMin
 }
 
-func CrearHash[K any, V any](igualdad func(K, K) bool) Diccionario[K, V] {
-	h := &hashCerrado[K, V]{igualdad: igualdad}
-	h.crearTabla(CAPACIDAD_INICIAL)
-	return h
+func (h *hashCerrado[K, V]) capacidadAgrandada() int {
+	return capacidadAgrandada(h.capacidad, h.factorRedimension)
 }
 
-func (h *hashCerrado[K, V]) factorCarga() float64 {
-	return float64(h.cantidad+h.borrados) / float64(h.capacidad)
+func (h *hashCerrado[K, V]) capacidadAchicada() int {
+	return capacidadAchicada(h.capacidad, h.capacidadMinima, h.factorRedimension)
 }
 
-func (h *hashCerrado[K, V]) debeAgrandar() bool {
-	return h.factorCarga() > FACTOR_CARGA_MAX
+func capacidadAgrandada(capacidad int, factorRedimension float64) int {
+	return max(int(float64(capacidad)*factorRedimension), capacidad+1)
 }
 
-func (h *hashCerrado[K, V]) debeAchicar() bool {
-	return h.capacidad > CAPACIDAD_MINIMA && h.factorCarga() < FACTOR_CARGA_MIN
+func capacidadAchicada(capacidad, capacidadMinima int, factorRedimension float64) int {
+	return max(int(float64(capacidad)/factorRedimension), capacidadMinima)
 }
 
 func (h *hashCerrado[K, V]) redimensionar(nuevaCapacidad int) {
-	tablaVieja := h.tabla
+	h.terminarMigracion()
+	tablaVieja, cantidad := h.tabla, h.cantidad
 	h.crearTabla(nuevaCapacidad)
+
+	h.modificaciones++
+
+	if h.incremental {
+		h.tablaVieja = tablaVieja
+		h.migrados = 0
+		h.cantidad = cantidad
+		h.cantidadVieja = cantidad
+		return
+	}
+
 	for _, c := range tablaVieja {
-		if c != nil && c.estado == OCUPADO {
-			h.Guardar(c.clave, c.valor)
+		if c.estado == OCUPADO {
+			pos, _ := h.buscarParaInsertar(c.clave)
+			h.tabla[pos] = c
+			h.cantidad++
+		}
+	}
+}
+
+// migrar pasa a la tabla nueva las claves de las siguientes posiciones de la tabla vieja, y sigue hasta pasar
+// por una celda vacía. Así lo migrado son siempre clusters enteros: ninguna búsqueda en la tabla vieja de una
+// clave que falta migrar pasa por lo migrado, y sus celdas pueden quedar vacías en lugar de borradas, que
+// alargarían las búsquedas de las claves que no están. Invalida a los iteradores, que recorren ambas tablas por
+// posición y podrían saltearse o repetir las claves movidas.
+func (h *hashCerrado[K, V]) migrar(pasos int) {
+	if h.tablaVieja != nil {
+		h.modificaciones++
+	}
+	for ; h.tablaVieja != nil; pasos-- {
+		c := h.tablaVieja[h.migrados]
+		if c.estado == OCUPADO {
+			pos, _ := h.buscarParaInsertar(c.clave)
+			if h.tabla[pos].estado == BORRADO {
+				h.borrados--
+			}
+			h.tabla[pos] = c
+			h.cantidadVieja--
+		}
+		h.tablaVieja[h.migrados] = celda[K, V]{}
+		h.migrados++
+
+		if h.migrados == len(h.tablaVieja) {
+			h.tablaVieja = nil
+			h.migrados = 0
+		}
+		if pasos <= 1 && c.estado == VACIO {
+			return
 		}
 	}
 }
 
-func (h *hashCerrado[K, V]) buscar(clave K) (int, bool) {
-	pos := funcionHash(clave, h.capacidad)
+func (h *hashCerrado[K, V]) terminarMigracion() {
+	if h.tablaVieja != nil {
+		h.migrar(len(h.tablaVieja) - h.migrados)
+	}
+}
+
+// buscarEn busca la clave en la tabla indicada, devolviendo la posición donde se encontró o donde terminó la
+// búsqueda
+func (h *hashCerrado[K, V]) buscarEn(tabla []celda[K, V], clave K) (int, bool) {
+	capacidad := len(tabla)
+	pos := h.posicionInicial(clave, capacidad)
 	inicio := pos
 
 	for {
-		if h.tabla[pos] == nil {
+		if tabla[pos].estado == VACIO {
 			return pos, false
 		}
 
-		if h.tabla[pos].estado == OCUPADO && h.igualdad(h.tabla[pos].clave, clave) {
+		if tabla[pos].estado == OCUPADO && h.igualdad(tabla[pos].clave, clave) {
 			return pos, true
 		}
 
-		pos = (pos + 1) % h.capacidad
+		pos = (pos + 1) % capacidad
 		if pos == inicio {
 			return pos, false
 		}
 	}
 }
 
+// buscarCelda devuelve la celda de la clave, buscándola también en la tabla vieja si se está migrando, o nil
+// si no está. enVieja indica si se encontró en la tabla vieja.
+func (h *hashCerrado[K, V]) buscarCelda(clave K) (c *celda[K, V], enVieja bool) {
+	if pos, existe := h.buscarEn(h.tabla, clave); existe {
+		return &h.tabla[pos], false
+	}
+	if h.tablaVieja != nil {
+		if pos, existe := h.buscarEn(h.tablaVieja, clave); existe {
+			return &h.tablaVieja[pos], true
+		}
+	}
+	return nil, false
+}
+
 func (h *hashCerrado[K, V]) buscarParaInsertar(clave K) (int, bool) {
-	pos := funcionHash(clave, h.capacidad)
+	pos := h.posicionInicial(clave, h.capacidad)
 	inicio := pos
 	primerBorrado := -1
 	claveExiste := false
 
 	for {
-		if h.tabla[pos] == nil {
+		if h.tabla[pos].estado == VACIO {
 			break
 		}
 
@@ -185,71 +356,169 @@ func (h *hashCerrado[K, V]) buscarParaInsertar(clave K) (int, bool) {
 	return pos, claveExiste
 }
 
+// Guardar sólo migra, compacta o redimensiona al agregar una clave nueva: actualizar el dato de una que ya
+// está no mueve ninguna celda, así que no invalida a los iteradores.
 func (h *hashCerrado[K, V]) Guardar(clave K, valor V) {
-	if h.debeAgrandar() {
-		h.redimensionar(h.capacidad * FACTOR_REDIMENSION)
-	}
-
 	pos, existe := h.buscarParaInsertar(clave)
-
 	if existe {
 		h.tabla[pos].valor = valor
-	} else {
-		if h.tabla[pos] != nil && h.tabla[pos].estado == BORRADO {
-			h.borrados--
-		}
-		h.tabla[pos] = &celda[K, V]{
-			clave:  clave,
-			valor:  valor,
-			estado: OCUPADO,
+		return
+	}
+	if h.tablaVieja != nil {
+		if c, enVieja := h.buscarCelda(clave); enVieja {
+			c.valor = valor
+			return
 		}
-		h.cantidad++
 	}
+
+	modificaciones := h.modificaciones
+	h.migrar(PASOS_MIGRACION)
+	if h.debeCompactar() {
+		h.Compactar()
+	}
+	if h.debeAgrandar() {
+		h.redimensionar(h.capacidadAgrandada())
+	}
+	// Si se movieron celdas, la posición encontrada puede haber dejado de estar libre
+	if h.modificaciones != modificaciones {
+		pos, _ = h.buscarParaInsertar(clave)
+	}
+
+	if h.tabla[pos].estado == BORRADO {
+		h.borrados--
+	}
+	h.tabla[pos] = celda[K, V]{
+		clave:  clave,
+		valor:  valor,
+		estado: OCUPADO,
+	}
+	h.cantidad++
+	h.modificaciones++
 }
 
 func (h *hashCerrado[K, V]) Pertenece(clave K) bool {
-	_, existe := h.buscar(clave)
-	return existe
+	c, _ := h.buscarCelda(clave)
+	return c != nil
 }
 
 func (h *hashCerrado[K, V]) Obtener(clave K) V {
-	pos, existe := h.buscar(clave)
-	if !existe {
+	c, _ := h.buscarCelda(clave)
+	if c == nil {
 		panic(MENSAJE_CLAVE_INEXIST)
 	}
-	return h.tabla[pos].valor
+	return c.valor
 }
 
 func (h *hashCerrado[K, V]) Borrar(clave K) V {
-	pos, existe := h.buscar(clave)
-	if !existe {
+	h.migrar(PASOS_MIGRACION)
+	c, enVieja := h.buscarCelda(clave)
+	if c == nil {
 		panic(MENSAJE_CLAVE_INEXIST)
 	}
 
-	valor := h.tabla[pos].valor
-	h.tabla[pos].estado = BORRADO
+	valor := c.valor
+	// Limpiamos la clave y el valor para no retener memoria a la que ya no se puede llegar
+	*c = celda[K, V]{estado: BORRADO}
 	h.cantidad--
-	h.borrados++
+	if enVieja {
+		h.cantidadVieja--
+	} else {
+		h.borrados++
+	}
+	h.modificaciones++
 
 	if h.debeAchicar() {
-		nuevaCap := h.capacidad / FACTOR_REDIMENSION
-		if nuevaCap < CAPACIDAD_MINIMA {
-			nuevaCap = CAPACIDAD_MINIMA
-		}
-		h.redimensionar(nuevaCap)
+		h.redimensionar(h.capacidadAchicada())
+	} else if h.debeCompactar() {
+		h.Compactar()
 	}
 
 	return valor
 }
 
+// Compactable lo implementan los hashes que pueden eliminar sus celdas BORRADO sin redimensionarse
+type Compactable interface {
+	// Compactar elimina las celdas borradas, para que las búsquedas no tengan que pasar por ellas. Invalida a
+	// los iteradores existentes.
+	Compactar()
+}
+
+// debeCompactar indica si conviene limpiar las celdas BORRADO: porque son demasiadas, o porque la tabla se
+// llenó más por ellas que por las claves y agrandarla desperdiciaría memoria. Con una cantidad de claves
+// estable la tabla no se redimensiona, y sin compactar los clusters sólo crecerían. Durante una redimensión
+// incremental no se compacta, para no cortarla. Sólo se consulta al guardar una clave nueva o al borrar, que
+// ya invalidan a los iteradores.
+func (h *hashCerrado[K, V]) debeCompactar() bool {
+	if h.tablaVieja != nil || h.borrados == 0 {
+		return false
+	}
+	return float64(h.borrados) > float64(h.capacidad)*FACTOR_BORRADOS_MAX ||
+		(h.debeAgrandar() && h.borrados >= h.cantidad)
+}
+
+// Compactar vacía cada celda BORRADO sin cambiar la capacidad ni pedir memoria. Al vaciar una celda se corren
+// hacia ella las claves siguientes que dejarían de encontrarse (algoritmo R de Knuth).
+func (h *hashCerrado[K, V]) Compactar() {
+	h.terminarMigracion()
+	if h.borrados == 0 {
+		return
+	}
+	for i := range h.tabla {
+		if h.tabla[i].estado == BORRADO {
+			h.vaciar(i)
+		}
+	}
+	h.borrados = 0
+	h.modificaciones++
+}
+
+// vaciar deja vacía la posición hueco y recorre el resto del cluster: cada clave cuya búsqueda pasaría por el
+// hueco se mueve a él, y el hueco pasa a ser la posición que dejó libre
+func (h *hashCerrado[K, V]) vaciar(hueco int) {
+	h.tabla[hueco] = celda[K, V]{}
+	for j := (hueco + 1) % h.capacidad; h.tabla[j].estado != VACIO; j = (j + 1) % h.capacidad {
+		if h.tabla[j].estado == BORRADO {
+			continue
+		}
+		inicial := h.posicionInicial(h.tabla[j].clave, h.capacidad)
+		if entreCircular(hueco, inicial, j) {
+			continue
+		}
+		h.tabla[hueco] = h.tabla[j]
+		h.tabla[j] = celda[K, V]{}
+		hueco = j
+	}
+}
+
+// entreCircular indica si pos está en el intervalo (desde, hasta] de una tabla circular
+func entreCircular(desde, pos, hasta int) bool {
+	if desde < hasta {
+		return desde < pos && pos <= hasta
+	}
+	return desde < pos || pos <= hasta
+}
+
 func (h *hashCerrado[K, V]) Cantidad() int {
 	return h.cantidad
 }
 
+// celdaEn devuelve la celda en la posición i, contando primero las de la tabla nueva y luego las de la vieja
+func (h *hashCerrado[K, V]) celdaEn(i int) *celda[K, V] {
+	if i < h.capacidad {
+		return &h.tabla[i]
+	}
+	return &h.tablaVieja[i-h.capacidad]
+}
+
+func (h *hashCerrado[K, V]) totalCeldas() int {
+	return h.capacidad + len(h.tablaVieja)
+}
+
 // Iterador interno
 func (h *hashCerrado[K, V]) Iterar(visitar func(clave K, dato V) bool) {
-	for _, c := range h.tabla {
-		if c != nil && c.estado == OCUPADO {
+	for i := 0; i < h.totalCeldas(); i++ {
+		c := h.celdaEn(i)
+		if c.estado == OCUPADO {
 			if !visitar(c.clave, c.valor) {
 				return
 			}
@@ -259,24 +528,34 @@ func (h *hashCerrado[K, V]) Iterar(visitar func(clave K, dato V) bool) {
 
 // Iterador externo
 func (h *hashCerrado[K, V]) Iterador() IterDiccionario[K, V] {
-	iter := &iterHash[K, V]{hash: h, posicion: -1}
+	iter := &iterHash[K, V]{hash: h, posicion: -1, modificaciones: h.modificaciones}
 	iter.avanzar()
 	return iter
 }
 
 func (it *iterHash[K, V]) HaySiguiente() bool {
-	return it.posicion < it.hash.capacidad && it.posicion != -1
+	return it.posicion != -1
+}
+
+// verificarNoModificado entra en pánico si se guardó o borró alguna clave desde que se creó el iterador,
+// ya que la tabla pudo haberse redimensionado
+func (it *iterHash[K, V]) verificarNoModificado() {
+	if it.modificaciones != it.hash.modificaciones {
+		panic(MENSAJE_DICC_MODIFICADO)
+	}
 }
 
 func (it *iterHash[K, V]) VerActual() (K, V) {
+	it.verificarNoModificado()
 	if !it.HaySiguiente() {
 		panic(MENSAJE_ITER_TERMINADO)
 	}
-	celda := it.hash.tabla[it.posicion]
+	celda := it.hash.celdaEn(it.posicion)
 	return celda.clave, celda.valor
 }
 
 func (it *iterHash[K, V]) Siguiente() {
+	it.verificarNoModificado()
 	if !it.HaySiguiente() {
 		panic(MENSAJE_ITER_TERMINADO)
 	}
@@ -284,8 +563,8 @@ func (it *iterHash[K, V]) Siguiente() {
 }
 
 func (it *iterHash[K, V]) avanzar() {
-	for i := it.posicion + 1; i < it.hash.capacidad; i++ {
-		if it.hash.tabla[i] != nil && it.hash.tabla[i].estado == OCUPADO {
+	for i := it.posicion + 1; i < it.hash.totalCeldas(); i++ {
+		if c := it.hash.celdaEn(i); c.estado == OCUPADO {
 			it.posicion = i
 			return
 		}
@@ -0,0 +1,223 @@
+package diccionario
+
+import TDALista "tdas/lista"
+
+// Hash abierto: cada posición de la tabla guarda una lista con los pares cuyas claves caen en ella, por lo que
+// la tabla nunca se llena y puede trabajar con factores de carga mayores a 1.
+
+const (
+	FACTOR_CARGA_MAX_ABIERTO = 3
+	FACTOR_CARGA_MIN_ABIERTO = 0.5
+)
+
+type parClaveValor[K any, V any] struct {
+	clave K
+	valor V
+}
+
+type hashAbierto[K any, V any] struct {
+	tabla          []TDALista.Lista[*parClaveValor[K, V]]
+	capacidad      int
+	cantidad       int
+	igualdad       func(K, K) bool
+	hash           FuncionHash[K]
+	modificaciones int
+}
+
+type iterHashAbierto[K any, V any] struct {
+	hash           *hashAbierto[K, V]
+	posicion       int
+	iterLista      TDALista.IteradorLista[*parClaveValor[K, V]]
+	modificaciones int
+}
+
+// CrearHashAbierto crea un hash abierto vacío, cuyas posiciones son listas enlazadas
+func CrearHashAbierto[K any, V any](igualdad func(K, K) bool) Diccionario[K, V] {
+	h := &hashAbierto[K, V]{igualdad: igualdad, hash: conSemilla(hashPorDefecto[K], semillaAleatoria())}
+	h.crearTabla(CAPACIDAD_INICIAL)
+	return h
+}
+
+// crearTabla crea una tabla con todas sus listas vacías. Las listas se crean recién cuando se necesitan.
+func (h *hashAbierto[K, V]) crearTabla(capacidad int) {
+	h.tabla = make([]TDALista.Lista[*parClaveValor[K, V]], capacidad)
+	h.capacidad = capacidad
+	h.cantidad = 0
+}
+
+func (h *hashAbierto[K, V]) posicionInicial(clave K) int {
+	return int(h.hash(clave) % uint32(h.capacidad))
+}
+
+func (h *hashAbierto[K, V]) factorCarga() float64 {
+	return float64(h.cantidad) / float64(h.capacidad)
+}
+
+func (h *hashAbierto[K, V]) redimensionar(nuevaCapacidad int) {
+	tablaVieja := h.tabla
+	h.crearTabla(nuevaCapacidad)
+	for _, lista := range tablaVieja {
+		if lista == nil {
+			continue
+		}
+		lista.Iterar(func(par *parClaveValor[K, V]) bool {
+			h.listaDe(par.clave).InsertarUltimo(par)
+			h.cantidad++
+			return true
+		})
+	}
+}
+
+// listaDe devuelve la lista de la posición que le corresponde a la clave, creándola si hace falta
+func (h *hashAbierto[K, V]) listaDe(clave K) TDALista.Lista[*parClaveValor[K, V]] {
+	pos := h.posicionInicial(clave)
+	if h.tabla[pos] == nil {
+		h.tabla[pos] = TDALista.CrearListaEnlazada[*parClaveValor[K, V]]()
+	}
+	return h.tabla[pos]
+}
+
+// buscar devuelve el par con la clave, o nil si no está
+func (h *hashAbierto[K, V]) buscar(clave K) *parClaveValor[K, V] {
+	lista := h.tabla[h.posicionInicial(clave)]
+	if lista == nil {
+		return nil
+	}
+
+	var encontrado *parClaveValor[K, V]
+	lista.Iterar(func(par *parClaveValor[K, V]) bool {
+		if h.igualdad(par.clave, clave) {
+			encontrado = par
+			return false
+		}
+		return true
+	})
+	return encontrado
+}
+
+func (h *hashAbierto[K, V]) Guardar(clave K, valor V) {
+	if par := h.buscar(clave); par != nil {
+		par.valor = valor
+		return
+	}
+
+	if h.factorCarga() >= FACTOR_CARGA_MAX_ABIERTO {
+		h.redimensionar(h.capacidad * FACTOR_REDIMENSION)
+	}
+	h.listaDe(clave).InsertarUltimo(&parClaveValor[K, V]{clave: clave, valor: valor})
+	h.cantidad++
+	h.modificaciones++
+}
+
+func (h *hashAbierto[K, V]) Pertenece(clave K) bool {
+	return h.buscar(clave) != nil
+}
+
+func (h *hashAbierto[K, V]) Obtener(clave K) V {
+	par := h.buscar(clave)
+	if par == nil {
+		panic(MENSAJE_CLAVE_INEXIST)
+	}
+	return par.valor
+}
+
+func (h *hashAbierto[K, V]) Borrar(clave K) V {
+	lista := h.tabla[h.posicionInicial(clave)]
+	if lista == nil {
+		panic(MENSAJE_CLAVE_INEXIST)
+	}
+
+	iter := lista.Iterador()
+	for iter.HaySiguiente() && !h.igualdad(iter.VerActual().clave, clave) {
+		iter.Siguiente()
+	}
+	if !iter.HaySiguiente() {
+		panic(MENSAJE_CLAVE_INEXIST)
+	}
+
+	valor := iter.Borrar().valor
+	h.cantidad--
+	h.modificaciones++
+
+	if h.capacidad > CAPACIDAD_MINIMA && h.factorCarga() < FACTOR_CARGA_MIN_ABIERTO {
+		nuevaCap := h.capacidad / FACTOR_REDIMENSION
+		if nuevaCap < CAPACIDAD_MINIMA {
+			nuevaCap = CAPACIDAD_MINIMA
+		}
+		h.redimensionar(nuevaCap)
+	}
+
+	return valor
+}
+
+func (h *hashAbierto[K, V]) Cantidad() int {
+	return h.cantidad
+}
+
+// Iterador interno
+func (h *hashAbierto[K, V]) Iterar(visitar func(clave K, dato V) bool) {
+	seguir := true
+	for _, lista := range h.tabla {
+		if lista == nil {
+			continue
+		}
+		lista.Iterar(func(par *parClaveValor[K, V]) bool {
+			seguir = visitar(par.clave, par.valor)
+			return seguir
+		})
+		if !seguir {
+			return
+		}
+	}
+}
+
+// Iterador externo
+func (h *hashAbierto[K, V]) Iterador() IterDiccionario[K, V] {
+	iter := &iterHashAbierto[K, V]{hash: h, posicion: -1, modificaciones: h.modificaciones}
+	iter.avanzar()
+	return iter
+}
+
+func (it *iterHashAbierto[K, V]) verificarNoModificado() {
+	if it.modificaciones != it.hash.modificaciones {
+		panic(MENSAJE_DICC_MODIFICADO)
+	}
+}
+
+func (it *iterHashAbierto[K, V]) HaySiguiente() bool {
+	return it.iterLista != nil && it.iterLista.HaySiguiente()
+}
+
+func (it *iterHashAbierto[K, V]) VerActual() (K, V) {
+	it.verificarNoModificado()
+	if !it.HaySiguiente() {
+		panic(MENSAJE_ITER_TERMINADO)
+	}
+	par := it.iterLista.VerActual()
+	return par.clave, par.valor
+}
+
+func (it *iterHashAbierto[K, V]) Siguiente() {
+	it.verificarNoModificado()
+	if !it.HaySiguiente() {
+		panic(MENSAJE_ITER_TERMINADO)
+	}
+	it.iterLista.Siguiente()
+	if !it.iterLista.HaySiguiente() {
+		it.avanzar()
+	}
+}
+
+// avanzar posiciona al iterador al principio de la siguiente lista no vacía
+func (it *iterHashAbierto[K, V]) avanzar() {
+	for i := it.posicion + 1; i < it.hash.capacidad; i++ {
+		lista := it.hash.tabla[i]
+		if lista != nil && !lista.EstaVacia() {
+			it.posicion = i
+			it.iterLista = lista.Iterador()
+			return
+		}
+	}
+	it.posicion = it.hash.capacidad
+	it.iterLista = nil
+}
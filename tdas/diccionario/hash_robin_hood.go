@@ -0,0 +1,241 @@
+package diccionario
+
+// Hash cerrado con Robin Hood hashing: al insertar, una clave que está más lejos de su posición inicial le
+// quita el lugar a la que está más cerca de la suya. Así las distancias quedan parejas, una búsqueda puede
+// cortar apenas encuentra una clave más cerca de su posición que la buscada, y al borrar se corren hacia atrás
+// las claves siguientes en lugar de dejar celdas BORRADO.
+
+const FACTOR_CARGA_MAX_ROBIN_HOOD = 0.9
+
+type celdaRobinHood[K any, V any] struct {
+	clave     K
+	valor     V
+	distancia int // cuántas posiciones está corrida respecto de su posición inicial
+	ocupada   bool
+}
+
+type hashRobinHood[K any, V any] struct {
+	tabla          []celdaRobinHood[K, V]
+	capacidad      int
+	cantidad       int
+	igualdad       func(K, K) bool
+	hash           FuncionHash[K]
+	modificaciones int
+
+	capacidadMinima   int
+	factorCargaMax    float64
+	factorCargaMin    float64
+	factorRedimension float64
+	achicar           bool
+}
+
+type iterHashRobinHood[K any, V any] struct {
+	hash           *hashRobinHood[K, V]
+	posicion       int
+	modificaciones int
+}
+
+// CrearHashRobinHood crea un hash cerrado vacío que resuelve colisiones con Robin Hood hashing
+func CrearHashRobinHood[K any, V any](igualdad func(K, K) bool) Diccionario[K, V] {
+	return CrearHashRobinHoodConOpciones[K, V](igualdad, OpcionesHash[K]{})
+}
+
+// CrearHashRobinHoodConOpciones crea un hash Robin Hood vacío configurado según las opciones, que toman los
+// mismos valores por defecto que en CrearHashConOpciones salvo FactorCargaMax, que por defecto es
+// FACTOR_CARGA_MAX_ROBIN_HOOD. Como no deja celdas borradas, no admite RedimensionIncremental.
+func CrearHashRobinHoodConOpciones[K any, V any](igualdad func(K, K) bool, opciones OpcionesHash[K]) Diccionario[K, V] {
+	if opciones.RedimensionIncremental {
+		panic(MENSAJE_OPCIONES_INVALIDAS)
+	}
+	capacidad := completarOpciones(&opciones, FACTOR_CARGA_MAX_ROBIN_HOOD)
+	h := &hashRobinHood[K, V]{
+		igualdad:          igualdad,
+		hash:              opciones.Hash,
+		capacidadMinima:   capacidad,
+		factorCargaMax:    opciones.FactorCargaMax,
+		factorCargaMin:    opciones.FactorCargaMin,
+		factorRedimension: opciones.FactorRedimension,
+		achicar:           !opciones.SinAchicar,
+	}
+	h.crearTabla(capacidad)
+	return h
+}
+
+func (h *hashRobinHood[K, V]) crearTabla(capacidad int) {
+	h.tabla = make([]celdaRobinHood[K, V], capacidad)
+	h.capacidad = capacidad
+	h.cantidad = 0
+}
+
+func (h *hashRobinHood[K, V]) posicionInicial(clave K) int {
+	return int(h.hash(clave) % uint32(h.capacidad))
+}
+
+func (h *hashRobinHood[K, V]) siguientePosicion(pos int) int {
+	pos++
+	if pos == h.capacidad {
+		return 0
+	}
+	return pos
+}
+
+func (h *hashRobinHood[K, V]) debeAgrandar() bool {
+	return float64(h.cantidad+1) > float64(h.capacidad)*h.factorCargaMax
+}
+
+func (h *hashRobinHood[K, V]) debeAchicar() bool {
+	return h.achicar && h.capacidad > h.capacidadMinima &&
+		float64(h.cantidad)/float64(h.capacidad) < h.factorCargaMin
+}
+
+func (h *hashRobinHood[K, V]) redimensionar(nuevaCapacidad int) {
+	tablaVieja := h.tabla
+	h.crearTabla(nuevaCapacidad)
+	for _, c := range tablaVieja {
+		if c.ocupada {
+			h.insertar(c.clave, c.valor)
+		}
+	}
+}
+
+// buscar devuelve la posición de la clave y si se encontró
+func (h *hashRobinHood[K, V]) buscar(clave K) (int, bool) {
+	pos := h.posicionInicial(clave)
+	for distancia := 0; ; distancia++ {
+		c := &h.tabla[pos]
+		// Si la clave estuviera más adelante, habría desplazado a esta celda al insertarse
+		if !c.ocupada || c.distancia < distancia {
+			return pos, false
+		}
+		if h.igualdad(c.clave, clave) {
+			return pos, true
+		}
+		pos = h.siguientePosicion(pos)
+	}
+}
+
+// insertar agrega una clave que se sabe que no está, y hay lugar para ella
+func (h *hashRobinHood[K, V]) insertar(clave K, valor V) {
+	nueva := celdaRobinHood[K, V]{clave: clave, valor: valor, distancia: 0, ocupada: true}
+	pos := h.posicionInicial(clave)
+
+	for h.tabla[pos].ocupada {
+		if h.tabla[pos].distancia < nueva.distancia {
+			h.tabla[pos], nueva = nueva, h.tabla[pos]
+		}
+		nueva.distancia++
+		pos = h.siguientePosicion(pos)
+	}
+	h.tabla[pos] = nueva
+	h.cantidad++
+}
+
+func (h *hashRobinHood[K, V]) Guardar(clave K, valor V) {
+	pos, existe := h.buscar(clave)
+	if existe {
+		h.tabla[pos].valor = valor
+		return
+	}
+
+	if h.debeAgrandar() {
+		h.redimensionar(capacidadAgrandada(h.capacidad, h.factorRedimension))
+	}
+	h.insertar(clave, valor)
+	h.modificaciones++
+}
+
+func (h *hashRobinHood[K, V]) Pertenece(clave K) bool {
+	_, existe := h.buscar(clave)
+	return existe
+}
+
+func (h *hashRobinHood[K, V]) Obtener(clave K) V {
+	pos, existe := h.buscar(clave)
+	if !existe {
+		panic(MENSAJE_CLAVE_INEXIST)
+	}
+	return h.tabla[pos].valor
+}
+
+func (h *hashRobinHood[K, V]) Borrar(clave K) V {
+	pos, existe := h.buscar(clave)
+	if !existe {
+		panic(MENSAJE_CLAVE_INEXIST)
+	}
+	valor := h.tabla[pos].valor
+
+	// Corremos un lugar hacia atrás a las claves siguientes que no estén en su posición inicial
+	siguiente := h.siguientePosicion(pos)
+	for h.tabla[siguiente].ocupada && h.tabla[siguiente].distancia > 0 {
+		h.tabla[pos] = h.tabla[siguiente]
+		h.tabla[pos].distancia--
+		pos = siguiente
+		siguiente = h.siguientePosicion(pos)
+	}
+	h.tabla[pos] = celdaRobinHood[K, V]{}
+	h.cantidad--
+	h.modificaciones++
+
+	if h.debeAchicar() {
+		h.redimensionar(capacidadAchicada(h.capacidad, h.capacidadMinima, h.factorRedimension))
+	}
+
+	return valor
+}
+
+func (h *hashRobinHood[K, V]) Cantidad() int {
+	return h.cantidad
+}
+
+// Iterador interno
+func (h *hashRobinHood[K, V]) Iterar(visitar func(clave K, dato V) bool) {
+	for _, c := range h.tabla {
+		if c.ocupada && !visitar(c.clave, c.valor) {
+			return
+		}
+	}
+}
+
+// Iterador externo
+func (h *hashRobinHood[K, V]) Iterador() IterDiccionario[K, V] {
+	iter := &iterHashRobinHood[K, V]{hash: h, posicion: -1, modificaciones: h.modificaciones}
+	iter.avanzar()
+	return iter
+}
+
+func (it *iterHashRobinHood[K, V]) verificarNoModificado() {
+	if it.modificaciones != it.hash.modificaciones {
+		panic(MENSAJE_DICC_MODIFICADO)
+	}
+}
+
+func (it *iterHashRobinHood[K, V]) HaySiguiente() bool {
+	return it.posicion != -1
+}
+
+func (it *iterHashRobinHood[K, V]) VerActual() (K, V) {
+	it.verificarNoModificado()
+	if !it.HaySiguiente() {
+		panic(MENSAJE_ITER_TERMINADO)
+	}
+	c := it.hash.tabla[it.posicion]
+	return c.clave, c.valor
+}
+
+func (it *iterHashRobinHood[K, V]) Siguiente() {
+	it.verificarNoModificado()
+	if !it.HaySiguiente() {
+		panic(MENSAJE_ITER_TERMINADO)
+	}
+	it.avanzar()
+}
+
+func (it *iterHashRobinHood[K, V]) avanzar() {
+	for i := it.posicion + 1; i < it.hash.capacidad; i++ {
+		if it.hash.tabla[i].ocupada {
+			it.posicion = i
+			return
+		}
+	}
+	it.posicion = -1
+}
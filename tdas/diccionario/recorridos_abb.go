@@ -0,0 +1,235 @@
+package diccionario
+
+import (
+	TDACola "tdas/cola"
+	TDAPila "tdas/pila"
+)
+
+const MENSAJE_PREORDER_INVALIDO = "La secuencia no es el preorder de un ABB"
+
+// ConRecorridos lo implementan los árboles que se pueden recorrer en otros órdenes además del de sus claves.
+// Estos recorridos dependen de la forma del árbol, por ejemplo para serializarlo y reconstruirlo igual.
+type ConRecorridos[K any, V any] interface {
+	// IterarPreorder visita cada nodo antes que a sus hijos, primero el izquierdo
+	IterarPreorder(visitar func(clave K, dato V) bool)
+
+	// IterarPostorder visita cada nodo después que a sus hijos, primero el izquierdo
+	IterarPostorder(visitar func(clave K, dato V) bool)
+
+	// IterarPorNiveles visita los nodos de menor a mayor profundidad, y los de un mismo nivel de izquierda a
+	// derecha
+	IterarPorNiveles(visitar func(clave K, dato V) bool)
+
+	// IteradorPreorder, IteradorPostorder e IteradorPorNiveles crean un IterDiccionario con cada recorrido
+	IteradorPreorder() IterDiccionario[K, V]
+	IteradorPostorder() IterDiccionario[K, V]
+	IteradorPorNiveles() IterDiccionario[K, V]
+}
+
+type ordenRecorrido int
+
+const (
+	PREORDER ordenRecorrido = iota
+	POSTORDER
+	POR_NIVELES
+)
+
+// iterRecorrido guarda en una pila (o en una cola, para el recorrido por niveles) los nodos que faltan visitar.
+// actual es el nodo en el que está parado, o nil si terminó.
+type iterRecorrido[K any, V any] struct {
+	abb            *abb[K, V]
+	orden          ordenRecorrido
+	pila           TDAPila.Pila[*nodoAbb[K, V]]
+	cola           TDACola.Cola[*nodoAbb[K, V]]
+	actual         *nodoAbb[K, V]
+	modificaciones int
+}
+
+// Iterador interno
+func (a *abb[K, V]) IterarPreorder(visitar func(K, V) bool) {
+	a.iterarRecorrido(PREORDER, visitar)
+}
+
+func (a *abb[K, V]) IterarPostorder(visitar func(K, V) bool) {
+	a.iterarRecorrido(POSTORDER, visitar)
+}
+
+func (a *abb[K, V]) IterarPorNiveles(visitar func(K, V) bool) {
+	a.iterarRecorrido(POR_NIVELES, visitar)
+}
+
+func (a *abb[K, V]) iterarRecorrido(orden ordenRecorrido, visitar func(K, V) bool) {
+	for iter := a.crearIteradorRecorrido(orden); iter.actual != nil; iter.avanzar() {
+		if !visitar(iter.actual.clave, iter.actual.dato) {
+			return
+		}
+	}
+}
+
+// Iterador externo
+func (a *abb[K, V]) IteradorPreorder() IterDiccionario[K, V] {
+	return a.crearIteradorRecorrido(PREORDER)
+}
+
+func (a *abb[K, V]) IteradorPostorder() IterDiccionario[K, V] {
+	return a.crearIteradorRecorrido(POSTORDER)
+}
+
+func (a *abb[K, V]) IteradorPorNiveles() IterDiccionario[K, V] {
+	return a.crearIteradorRecorrido(POR_NIVELES)
+}
+
+func (a *abb[K, V]) crearIteradorRecorrido(orden ordenRecorrido) *iterRecorrido[K, V] {
+	iter := &iterRecorrido[K, V]{abb: a, orden: orden, modificaciones: a.modificaciones}
+	switch orden {
+	case PREORDER:
+		iter.pila = TDAPila.CrearPilaDinamica[*nodoAbb[K, V]]()
+		if a.raiz != nil {
+			iter.pila.Apilar(a.raiz)
+		}
+	case POSTORDER:
+		iter.pila = TDAPila.CrearPilaDinamica[*nodoAbb[K, V]]()
+		iter.apilarHastaHoja(a.raiz)
+	case POR_NIVELES:
+		iter.cola = TDACola.CrearColaEnlazada[*nodoAbb[K, V]]()
+		if a.raiz != nil {
+			iter.cola.Encolar(a.raiz)
+		}
+	}
+	iter.avanzar()
+	return iter
+}
+
+// apilarHastaHoja apila el camino desde el nodo hasta la primera hoja del postorder de su subárbol, bajando
+// por la izquierda siempre que se pueda
+func (iter *iterRecorrido[K, V]) apilarHastaHoja(nodo *nodoAbb[K, V]) {
+	for nodo != nil {
+		iter.pila.Apilar(nodo)
+		if nodo.izquierdo != nil {
+			nodo = nodo.izquierdo
+		} else {
+			nodo = nodo.derecho
+		}
+	}
+}
+
+// avanzar pasa al siguiente nodo del recorrido
+func (iter *iterRecorrido[K, V]) avanzar() {
+	switch iter.orden {
+	case PREORDER:
+		if iter.pila.EstaVacia() {
+			iter.actual = nil
+			return
+		}
+		iter.actual = iter.pila.Desapilar()
+		if iter.actual.derecho != nil {
+			iter.pila.Apilar(iter.actual.derecho)
+		}
+		if iter.actual.izquierdo != nil {
+			iter.pila.Apilar(iter.actual.izquierdo)
+		}
+	case POSTORDER:
+		if iter.pila.EstaVacia() {
+			iter.actual = nil
+			return
+		}
+		iter.actual = iter.pila.Desapilar()
+		// Si terminamos el subárbol izquierdo del padre, sigue el derecho antes que el padre
+		if !iter.pila.EstaVacia() {
+			padre := iter.pila.VerTope()
+			if padre.izquierdo == iter.actual && padre.derecho != nil {
+				iter.apilarHastaHoja(padre.derecho)
+			}
+		}
+	case POR_NIVELES:
+		if iter.cola.EstaVacia() {
+			iter.actual = nil
+			return
+		}
+		iter.actual = iter.cola.Desencolar()
+		if iter.actual.izquierdo != nil {
+			iter.cola.Encolar(iter.actual.izquierdo)
+		}
+		if iter.actual.derecho != nil {
+			iter.cola.Encolar(iter.actual.derecho)
+		}
+	}
+}
+
+func (iter *iterRecorrido[K, V]) verificarNoModificado() {
+	if iter.modificaciones != iter.abb.modificaciones {
+		panic(MENSAJE_DICC_MODIFICADO)
+	}
+}
+
+func (iter *iterRecorrido[K, V]) HaySiguiente() bool {
+	return iter.actual != nil
+}
+
+func (iter *iterRecorrido[K, V]) VerActual() (K, V) {
+	iter.verificarNoModificado()
+	if !iter.HaySiguiente() {
+		panic(MENSAJE_ITER_TERMINADO)
+	}
+	return iter.actual.clave, iter.actual.dato
+}
+
+func (iter *iterRecorrido[K, V]) Siguiente() {
+	iter.verificarNoModificado()
+	if !iter.HaySiguiente() {
+		panic(MENSAJE_ITER_TERMINADO)
+	}
+	iter.avanzar()
+}
+
+// CrearABBDesdePreorder reconstruye, en tiempo lineal, el ABB cuyo recorrido preorder dio las claves dadas,
+// con datos[i] como dato de claves[i]. Si la secuencia no puede ser el preorder de un ABB según cmp (por
+// ejemplo, porque tiene claves repetidas), entra en pánico.
+func CrearABBDesdePreorder[K any, V any](claves []K, datos []V, cmp func(K, K) int) DiccionarioOrdenado[K, V] {
+	if len(claves) != len(datos) {
+		panic(MENSAJE_LARGOS_DISTINTOS)
+	}
+	a := &abb[K, V]{cmp: cmp, cantidad: len(claves)}
+	if len(claves) == 0 {
+		return a
+	}
+
+	// La pila tiene el camino desde la raíz hasta el último nodo agregado, sin los nodos en cuyo subárbol
+	// derecho ya estamos. Las claves que siguen tienen que ser mayores a cotaInferior, la del último de ellos.
+	nodos := make([]*nodoAbb[K, V], len(claves))
+	pila := TDAPila.CrearPilaDinamica[*nodoAbb[K, V]]()
+	var cotaInferior *K
+	for i := range claves {
+		nodo := &nodoAbb[K, V]{clave: claves[i], dato: datos[i]}
+		nodos[i] = nodo
+		if cotaInferior != nil && cmp(nodo.clave, *cotaInferior) <= 0 {
+			panic(MENSAJE_PREORDER_INVALIDO)
+		}
+
+		if i == 0 {
+			a.raiz = nodo
+		} else if comp := cmp(nodo.clave, pila.VerTope().clave); comp < 0 {
+			pila.VerTope().izquierdo = nodo
+		} else if comp == 0 {
+			panic(MENSAJE_PREORDER_INVALIDO)
+		} else {
+			var padre *nodoAbb[K, V]
+			for !pila.EstaVacia() && cmp(nodo.clave, pila.VerTope().clave) >= 0 {
+				padre = pila.Desapilar()
+				if cmp(nodo.clave, padre.clave) == 0 {
+					panic(MENSAJE_PREORDER_INVALIDO)
+				}
+			}
+			padre.derecho = nodo
+			cotaInferior = &padre.clave
+		}
+		pila.Apilar(nodo)
+	}
+
+	// En el preorder cada nodo está antes que sus descendientes, así que recorriéndolo al revés los tamaños de
+	// los hijos ya están calculados
+	for i := len(nodos) - 1; i >= 0; i-- {
+		actualizarAltura(nodos[i])
+	}
+	return a
+}
@@ -0,0 +1,333 @@
+package diccionario
+
+import "math"
+
+// Árbol rojo-negro inclinado a la izquierda (LLRB, de Sedgewick): cada nodo rojo representa, junto con su
+// padre, un nodo de un árbol 2-3. Se mantiene que la raíz es negra, que ningún nodo rojo tiene hijos rojos, que
+// sólo los hijos izquierdos son rojos, y que todos los caminos de la raíz a un nil pasan por la misma cantidad
+// de nodos negros. Con eso la altura es a lo sumo 2 log n. Al insertar hace en promedio menos rotaciones que
+// el AVL, porque tolera árboles menos balanceados. Comparte los nodos y los iteradores con el ABB.
+
+type arbolRojoNegro[K any, V any] struct {
+	abb[K, V]
+}
+
+// CrearArbolRojoNegro crea un nuevo árbol rojo-negro vacío con la func de cmp
+func CrearArbolRojoNegro[K any, V any](cmp func(K, K) int) DiccionarioOrdenado[K, V] {
+	return &arbolRojoNegro[K, V]{abb[K, V]{cmp: cmp}}
+}
+
+func esRojo[K any, V any](nodo *nodoAbb[K, V]) bool {
+	return nodo != nil && nodo.rojo
+}
+
+// Las rotaciones conservan el color que tenía el nodo en la posición de la raíz del subárbol
+func rotarIzquierdaRN[K any, V any](nodo *nodoAbb[K, V]) *nodoAbb[K, V] {
+	raiz := rotarIzquierda(nodo)
+	raiz.rojo, nodo.rojo = nodo.rojo, true
+	return raiz
+}
+
+func rotarDerechaRN[K any, V any](nodo *nodoAbb[K, V]) *nodoAbb[K, V] {
+	raiz := rotarDerecha(nodo)
+	raiz.rojo, nodo.rojo = nodo.rojo, true
+	return raiz
+}
+
+// invertirColores parte (o arma) un nodo de 4 claves del árbol 2-3 equivalente
+func invertirColores[K any, V any](nodo *nodoAbb[K, V]) {
+	nodo.rojo = !nodo.rojo
+	nodo.izquierdo.rojo = !nodo.izquierdo.rojo
+	nodo.derecho.rojo = !nodo.derecho.rojo
+}
+
+// arreglar restablece los invariantes en el camino de vuelta hacia la raíz
+func arreglar[K any, V any](nodo *nodoAbb[K, V]) *nodoAbb[K, V] {
+	if esRojo(nodo.derecho) && !esRojo(nodo.izquierdo) {
+		nodo = rotarIzquierdaRN(nodo)
+	}
+	if esRojo(nodo.izquierdo) && esRojo(nodo.izquierdo.izquierdo) {
+		nodo = rotarDerechaRN(nodo)
+	}
+	if esRojo(nodo.izquierdo) && esRojo(nodo.derecho) {
+		invertirColores(nodo)
+	}
+	actualizarTamanio(nodo)
+	return nodo
+}
+
+// moverRojoIzquierda asegura, antes de bajar por la izquierda, que el hijo izquierdo o uno de sus hijos sea rojo,
+// para que al borrar no quede un nodo 2-3 vacío
+func moverRojoIzquierda[K any, V any](nodo *nodoAbb[K, V]) *nodoAbb[K, V] {
+	invertirColores(nodo)
+	if esRojo(nodo.derecho.izquierdo) {
+		nodo.derecho = rotarDerechaRN(nodo.derecho)
+		nodo = rotarIzquierdaRN(nodo)
+		invertirColores(nodo)
+	}
+	return nodo
+}
+
+func moverRojoDerecha[K any, V any](nodo *nodoAbb[K, V]) *nodoAbb[K, V] {
+	invertirColores(nodo)
+	if esRojo(nodo.izquierdo.izquierdo) {
+		nodo = rotarDerechaRN(nodo)
+		invertirColores(nodo)
+	}
+	return nodo
+}
+
+// Guardar inserta o reemplaza una clave. Los nodos nuevos son rojos, y la raíz siempre queda negra.
+func (a *arbolRojoNegro[K, V]) Guardar(clave K, dato V) {
+	a.raiz = a.guardar(a.raiz, clave, dato)
+	a.raiz.rojo = false
+}
+
+func (a *arbolRojoNegro[K, V]) guardar(nodo *nodoAbb[K, V], clave K, dato V) *nodoAbb[K, V] {
+	if nodo == nil {
+		a.cantidad++
+		a.modificaciones++
+		return &nodoAbb[K, V]{clave: clave, dato: dato, tamanio: 1, rojo: true}
+	}
+
+	comp := a.cmp(clave, nodo.clave)
+	if comp == 0 {
+		nodo.dato = dato
+		return nodo
+	}
+	if comp < 0 {
+		nodo.izquierdo = a.guardar(nodo.izquierdo, clave, dato)
+	} else {
+		nodo.derecho = a.guardar(nodo.derecho, clave, dato)
+	}
+	return arreglar(nodo)
+}
+
+// Borrar elimina una clave y devuelve su valor. Como el borrado reestructura el árbol mientras baja, primero
+// se verifica que la clave esté.
+func (a *arbolRojoNegro[K, V]) Borrar(clave K) V {
+	_, nodo := a.buscarNodo(a.raiz, clave)
+	if nodo == nil {
+		panic(MENSAJE_CLAVE_INEXIST)
+	}
+	dato := nodo.dato
+
+	if !esRojo(a.raiz.izquierdo) && !esRojo(a.raiz.derecho) {
+		a.raiz.rojo = true
+	}
+	a.raiz = a.borrar(a.raiz, clave)
+	if a.raiz != nil {
+		a.raiz.rojo = false
+	}
+	a.cantidad--
+	a.modificaciones++
+	return dato
+}
+
+func (a *arbolRojoNegro[K, V]) borrar(nodo *nodoAbb[K, V], clave K) *nodoAbb[K, V] {
+	if a.cmp(clave, nodo.clave) < 0 {
+		if !esRojo(nodo.izquierdo) && !esRojo(nodo.izquierdo.izquierdo) {
+			nodo = moverRojoIzquierda(nodo)
+		}
+		nodo.izquierdo = a.borrar(nodo.izquierdo, clave)
+		return arreglar(nodo)
+	}
+
+	if esRojo(nodo.izquierdo) {
+		nodo = rotarDerechaRN(nodo)
+	}
+	if a.cmp(clave, nodo.clave) == 0 && nodo.derecho == nil {
+		return nil
+	}
+	if !esRojo(nodo.derecho) && !esRojo(nodo.derecho.izquierdo) {
+		nodo = moverRojoDerecha(nodo)
+	}
+	if a.cmp(clave, nodo.clave) == 0 {
+		// El nodo pasa a tener la clave del sucesor, que se borra del subárbol derecho
+		var sucesor *nodoAbb[K, V]
+		nodo.derecho = borrarMinimoRN(nodo.derecho, &sucesor)
+		nodo.clave, nodo.dato = sucesor.clave, sucesor.dato
+	} else {
+		nodo.derecho = a.borrar(nodo.derecho, clave)
+	}
+	return arreglar(nodo)
+}
+
+// borrarMinimoRN saca del subárbol al nodo con la menor clave, devolviéndolo en minimo
+func borrarMinimoRN[K any, V any](nodo *nodoAbb[K, V], minimo **nodoAbb[K, V]) *nodoAbb[K, V] {
+	if nodo.izquierdo == nil {
+		*minimo = nodo
+		return nil
+	}
+	if !esRojo(nodo.izquierdo) && !esRojo(nodo.izquierdo.izquierdo) {
+		nodo = moverRojoIzquierda(nodo)
+	}
+	nodo.izquierdo = borrarMinimoRN(nodo.izquierdo, minimo)
+	return arreglar(nodo)
+}
+
+// Rebalancear reacomoda los nodos en un árbol rojo-negro de la menor altura posible. El reparto del ABB no
+// sirve, porque no siempre se puede colorear respetando que sólo los hijos izquierdos sean rojos.
+func (a *arbolRojoNegro[K, V]) Rebalancear() {
+	nodos := a.nodosEnOrden()
+	a.raiz = construirRojoNegro(nodos, alturaNegraMinima(len(nodos)))
+	a.modificaciones++
+}
+
+// alturaNegraMinima devuelve la mayor altura negra h con la que un árbol 2-3 completo de altura h no tiene más
+// de n claves, es decir, la altura del árbol 2-3 más bajo que puede guardar n claves
+func alturaNegraMinima(n int) int {
+	h := 0
+	for minimasClaves23(h+1) <= n {
+		h++
+	}
+	return h
+}
+
+// Un árbol 2-3 con todas sus hojas a profundidad h tiene entre 2^h-1 claves (sólo nodos 2) y 3^h-1 (sólo nodos 3)
+func minimasClaves23(h int) int {
+	return 1<<h - 1
+}
+
+func maximasClaves23(h int) int {
+	claves := 1
+	for ; h > 0; h-- {
+		if claves > math.MaxInt/3 {
+			return math.MaxInt
+		}
+		claves *= 3
+	}
+	return claves - 1
+}
+
+// construirRojoNegro arma con los nodos ordenados el árbol rojo-negro equivalente a un árbol 2-3 de altura h. Si
+// los nodos que sobran entran en dos subárboles de altura h-1 la raíz es un nodo 2; si no, es un nodo 3, que se
+// representa con una raíz negra y su hijo izquierdo rojo.
+func construirRojoNegro[K any, V any](nodos []*nodoAbb[K, V], h int) *nodoAbb[K, V] {
+	if len(nodos) == 0 {
+		return nil
+	}
+
+	if len(nodos)-1 <= 2*maximasClaves23(h-1) {
+		medio := len(nodos) / 2
+		raiz := nodos[medio]
+		raiz.izquierdo = construirRojoNegro(nodos[:medio], h-1)
+		raiz.derecho = construirRojoNegro(nodos[medio+1:], h-1)
+		raiz.rojo = false
+		actualizarTamanio(raiz)
+		return raiz
+	}
+
+	// Los tres subárboles se llevan los nodos que quedan en partes que difieren a lo sumo en uno
+	tercio, resto := (len(nodos)-2)/3, (len(nodos)-2)%3
+	primero := tercio + min(resto, 1)
+	segundo := primero + 1 + tercio + resto/2
+	izquierdo, raiz := nodos[primero], nodos[segundo]
+	izquierdo.izquierdo = construirRojoNegro(nodos[:primero], h-1)
+	izquierdo.derecho = construirRojoNegro(nodos[primero+1:segundo], h-1)
+	izquierdo.rojo = true
+	actualizarTamanio(izquierdo)
+	raiz.izquierdo = izquierdo
+	raiz.derecho = construirRojoNegro(nodos[segundo+1:], h-1)
+	raiz.rojo = false
+	actualizarTamanio(raiz)
+	return raiz
+}
+
+// Dividir parte el árbol bajando por el camino de búsqueda de la clave y volviendo a unir, con unirRojoNegro,
+// los subárboles que quedan de cada lado. Lleva la cuenta de las alturas negras en lugar de recalcularlas, así
+// que, igual que en el AVL, el costo total es O(log n).
+func (a *arbolRojoNegro[K, V]) Dividir(clave K) (DiccionarioOrdenado[K, V], DiccionarioOrdenado[K, V]) {
+	menores, _, mayores, _ := a.dividir(a.raiz, alturaNegra(a.raiz), clave)
+	a.vaciar()
+	return &arbolRojoNegro[K, V]{abb[K, V]{raiz: menores, cantidad: tamanio(menores), cmp: a.cmp}},
+		&arbolRojoNegro[K, V]{abb[K, V]{raiz: mayores, cantidad: tamanio(mayores), cmp: a.cmp}}
+}
+
+// dividir recibe un subárbol de raíz negra y su altura negra, y devuelve los dos árboles resultantes con las
+// suyas
+func (a *arbolRojoNegro[K, V]) dividir(nodo *nodoAbb[K, V], negros int, clave K) (*nodoAbb[K, V], int, *nodoAbb[K, V], int) {
+	if nodo == nil {
+		return nil, 0, nil, 0
+	}
+	izq, negrosIzq := ennegrecer(nodo.izquierdo, negros-1)
+	der, negrosDer := ennegrecer(nodo.derecho, negros-1)
+	if a.cmp(nodo.clave, clave) < 0 {
+		menores, negrosMenores, mayores, negrosMayores := a.dividir(der, negrosDer, clave)
+		menores, negrosMenores = unirRojoNegro(izq, negrosIzq, nodo, menores, negrosMenores)
+		return menores, negrosMenores, mayores, negrosMayores
+	}
+	menores, negrosMenores, mayores, negrosMayores := a.dividir(izq, negrosIzq, clave)
+	mayores, negrosMayores = unirRojoNegro(mayores, negrosMayores, nodo, der, negrosDer)
+	return menores, negrosMenores, mayores, negrosMayores
+}
+
+// Unir saca la menor clave del árbol de claves mayores y la usa para unir ambos árboles. Es O(log n).
+func (a *arbolRojoNegro[K, V]) Unir(otro DiccionarioOrdenado[K, V]) {
+	rnOtro, ok := otro.(*arbolRojoNegro[K, V])
+	if !ok {
+		panic(MENSAJE_DICC_INCOMPATIBLE)
+	}
+	menor, mayor := a.ordenarParaUnir(&rnOtro.abb)
+	if mayor == nil {
+		a.raiz = menor
+	} else {
+		var medio *nodoAbb[K, V]
+		if !esRojo(mayor.izquierdo) && !esRojo(mayor.derecho) {
+			mayor.rojo = true
+		}
+		mayor = borrarMinimoRN(mayor, &medio)
+		mayor, negrosMayor := ennegrecer(mayor, alturaNegra(mayor))
+		a.raiz, _ = unirRojoNegro(menor, alturaNegra(menor), medio, mayor, negrosMayor)
+	}
+	a.absorber(&rnOtro.abb)
+}
+
+// alturaNegra cuenta los nodos negros de un camino cualquiera desde el nodo hasta un nil, incluyéndolo si es negro
+func alturaNegra[K any, V any](nodo *nodoAbb[K, V]) int {
+	negros := 0
+	for ; nodo != nil; nodo = nodo.izquierdo {
+		if !nodo.rojo {
+			negros++
+		}
+	}
+	return negros
+}
+
+// ennegrecer pinta de negro la raíz de un subárbol para usarlo como árbol por sí mismo, y devuelve su altura
+// negra a partir de la que tenía como subárbol
+func ennegrecer[K any, V any](nodo *nodoAbb[K, V], negros int) (*nodoAbb[K, V], int) {
+	if esRojo(nodo) {
+		nodo.rojo = false
+		negros++
+	}
+	return nodo, negros
+}
+
+// unirRojoNegro arma un árbol rojo-negro con las claves de izq, la de medio y las de der, en ese orden. izq y der
+// deben tener raíz negra. Devuelve el árbol con raíz negra y su altura negra.
+func unirRojoNegro[K any, V any](izq *nodoAbb[K, V], negrosIzq int, medio, der *nodoAbb[K, V], negrosDer int) (*nodoAbb[K, V], int) {
+	return ennegrecer(unirRN(izq, negrosIzq, medio, der, negrosDer), max(negrosIzq, negrosDer))
+}
+
+// unirRN baja por el borde del árbol de mayor altura negra hasta un subárbol negro con la misma altura negra que
+// el otro, y pone en su lugar a medio, rojo y con ambos árboles como hijos. Eso equivale a insertar una clave
+// en un nodo del árbol 2-3, así que al volver se arregla igual que después de Guardar.
+func unirRN[K any, V any](izq *nodoAbb[K, V], negrosIzq int, medio, der *nodoAbb[K, V], negrosDer int) *nodoAbb[K, V] {
+	switch {
+	case esRojo(der):
+		// Un hijo izquierdo rojo tiene la misma altura negra que su padre, sin contarlo a él
+		der.izquierdo = unirRN(izq, negrosIzq, medio, der.izquierdo, negrosDer)
+		return arreglar(der)
+	case negrosIzq > negrosDer:
+		// Los hijos derechos son siempre negros
+		izq.derecho = unirRN(izq.derecho, negrosIzq-1, medio, der, negrosDer)
+		return arreglar(izq)
+	case negrosIzq < negrosDer:
+		der.izquierdo = unirRN(izq, negrosIzq, medio, der.izquierdo, negrosDer-1)
+		return arreglar(der)
+	}
+	medio.izquierdo, medio.derecho, medio.rojo = izq, der, true
+	actualizarTamanio(medio)
+	return medio
+}
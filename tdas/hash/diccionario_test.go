@@ -2,7 +2,7 @@ package diccionario_test
 
 import (
 	"fmt"
-	TDADiccionario "tdas/diccionario"
+	TDADiccionario "tdas/hash"
 	"testing"
 
 	"github.com/stretchr/testify/require"
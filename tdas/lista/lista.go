@@ -26,12 +26,44 @@ type Lista[T any] interface {
 	// Largo devuelve la cantidad de elementos de la lista.
 	Largo() int
 
+	// Obtener devuelve el elemento en la posición i (contando desde 0). Si la posición no existe, entra en pánico
+	// con un mensaje "La posicion esta fuera de rango".
+	Obtener(i int) T
+
+	// InsertarEn agrega un nuevo elemento en la posición i, desplazando al que estaba ahí y a los siguientes.
+	// Las posiciones válidas van de 0 a Largo() inclusive. Si la posición no es válida, entra en pánico con un
+	// mensaje "La posicion esta fuera de rango".
+	InsertarEn(i int, dato T)
+
+	// BorrarEn saca el elemento en la posición i y lo devuelve. Si la posición no existe, entra en pánico con un
+	// mensaje "La posicion esta fuera de rango".
+	BorrarEn(i int) T
+
+	// Buscar devuelve la posición del primer elemento para el cual pred devuelve true, y true. Si ninguno
+	// cumple, devuelve -1 y false.
+	Buscar(pred func(T) bool) (int, bool)
+
+	// Ordenar ordena la lista de menor a mayor según cmp, que devuelve un número negativo si el primero es menor,
+	// 0 si son iguales y uno positivo si es mayor. El ordenamiento es estable: los elementos iguales conservan su
+	// orden relativo.
+	Ordenar(cmp func(T, T) int)
+
+	// InsertarOrdenado agrega un elemento manteniendo el orden de una lista ya ordenada según cmp. Si hay elementos
+	// iguales, el nuevo queda después de ellos.
+	InsertarOrdenado(dato T, cmp func(T, T) int)
+
+	// Concatenar mueve todos los elementos de otra al final de la lista, en O(1), dejando a otra vacía. Si otra
+	// es la misma lista, entra en pánico con un mensaje "No se puede concatenar una lista consigo misma".
+	Concatenar(otra Lista[T])
+
 	// Iterar aplica la función visitar a cada elemento de la lista en orden,
 	// desde el primero hasta el último, hasta que se termine la lista o
 	// la función visitar devuelva false.
 	Iterar(visitar func(T) bool)
 
-	// Iterador devuelve un iterador externo posicionado al inicio de la lista.
+	// Iterador devuelve un iterador externo posicionado al inicio de la lista. Si la lista se modifica por fuera
+	// del iterador mientras éste sigue en uso, sus operaciones (salvo HaySiguiente) entran en pánico con un mensaje
+	// "La lista fue modificada durante la iteracion".
 	Iterador() IteradorLista[T]
 }
 
@@ -48,6 +80,11 @@ type IteradorLista[T any] interface {
 	// Insertar agrega un elemento en la posición actual del iterador.
 	Insertar(T)
 
+	// InsertarLista mueve todos los elementos de otra a la posición actual del iterador, en O(1), dejando a otra
+	// vacía. El iterador queda posicionado sobre el primero de los elementos insertados. Si otra es la lista que
+	// se está iterando, entra en pánico con un mensaje "No se puede concatenar una lista consigo misma".
+	InsertarLista(otra Lista[T])
+
 	// Borrar elimina y devuelve el elemento actual del iterador.
 	Borrar() T
 }
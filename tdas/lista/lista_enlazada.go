@@ -6,20 +6,25 @@ type nodoLista[T any] struct {
 }
 
 type listaEnlazada[T any] struct {
-	primero *nodoLista[T]
-	ultimo  *nodoLista[T]
-	largo   int
+	primero        *nodoLista[T]
+	ultimo         *nodoLista[T]
+	largo          int
+	modificaciones int
 }
 
 type iterListaEnlazada[T any] struct {
-	lista    *listaEnlazada[T]
-	actual   *nodoLista[T]
-	anterior *nodoLista[T]
+	lista          *listaEnlazada[T]
+	actual         *nodoLista[T]
+	anterior       *nodoLista[T]
+	modificaciones int
 }
 
 const (
 	MENSAJE_LISTA_VACIA        = "La lista esta vacia"
 	MENSAJE_ITERADOR_TERMINADO = "El iterador termino de iterar"
+	MENSAJE_FUERA_DE_RANGO     = "La posicion esta fuera de rango"
+	MENSAJE_MISMA_LISTA        = "No se puede concatenar una lista consigo misma"
+	MENSAJE_LISTA_MODIFICADA   = "La lista fue modificada durante la iteracion"
 )
 
 //Lista
@@ -45,6 +50,24 @@ func (l *listaEnlazada[T]) verificarNoVacia() {
 	}
 }
 
+func (l *listaEnlazada[T]) verificarPosicion(i, maximo int) {
+	if i < 0 || i > maximo {
+		panic(MENSAJE_FUERA_DE_RANGO)
+	}
+}
+
+// nodoEn devuelve el nodo en la posición i, que se asume válida
+func (l *listaEnlazada[T]) nodoEn(i int) *nodoLista[T] {
+	if i == l.largo-1 {
+		return l.ultimo
+	}
+	actual := l.primero
+	for ; i > 0; i-- {
+		actual = actual.siguiente
+	}
+	return actual
+}
+
 func (l *listaEnlazada[T]) actualizarSiQuedaVacia() {
 	if l.primero == nil {
 		l.ultimo = nil
@@ -65,6 +88,7 @@ func (l *listaEnlazada[T]) InsertarPrimero(dato T) {
 	}
 	l.primero = nuevoNodo
 	l.largo++
+	l.modificaciones++
 }
 
 func (l *listaEnlazada[T]) InsertarUltimo(dato T) {
@@ -77,6 +101,7 @@ func (l *listaEnlazada[T]) InsertarUltimo(dato T) {
 	}
 	l.ultimo = nuevoNodo
 	l.largo++
+	l.modificaciones++
 }
 
 func (l *listaEnlazada[T]) BorrarPrimero() T {
@@ -88,6 +113,7 @@ func (l *listaEnlazada[T]) BorrarPrimero() T {
 	l.actualizarSiQuedaVacia()
 
 	l.largo--
+	l.modificaciones++
 	return dato
 }
 
@@ -105,6 +131,170 @@ func (l *listaEnlazada[T]) Largo() int {
 	return l.largo
 }
 
+func (l *listaEnlazada[T]) Obtener(i int) T {
+	l.verificarPosicion(i, l.largo-1)
+	return l.nodoEn(i).dato
+}
+
+func (l *listaEnlazada[T]) InsertarEn(i int, dato T) {
+	l.verificarPosicion(i, l.largo)
+
+	if i == 0 {
+		l.InsertarPrimero(dato)
+		return
+	}
+	if i == l.largo {
+		l.InsertarUltimo(dato)
+		return
+	}
+
+	anterior := l.nodoEn(i - 1)
+	nuevoNodo := crearNodo(dato)
+	nuevoNodo.siguiente = anterior.siguiente
+	anterior.siguiente = nuevoNodo
+	l.largo++
+	l.modificaciones++
+}
+
+func (l *listaEnlazada[T]) BorrarEn(i int) T {
+	l.verificarPosicion(i, l.largo-1)
+
+	if i == 0 {
+		return l.BorrarPrimero()
+	}
+
+	anterior := l.nodoEn(i - 1)
+	actual := anterior.siguiente
+	anterior.siguiente = actual.siguiente
+	if actual == l.ultimo {
+		l.ultimo = anterior
+	}
+	l.largo--
+	l.modificaciones++
+	return actual.dato
+}
+
+func (l *listaEnlazada[T]) Buscar(pred func(T) bool) (int, bool) {
+	i := 0
+	for actual := l.primero; actual != nil; actual = actual.siguiente {
+		if pred(actual.dato) {
+			return i, true
+		}
+		i++
+	}
+	return -1, false
+}
+
+func (l *listaEnlazada[T]) Ordenar(cmp func(T, T) int) {
+	l.primero = mergeSort(l.primero, cmp)
+	l.modificaciones++
+
+	l.ultimo = l.primero
+	for l.ultimo != nil && l.ultimo.siguiente != nil {
+		l.ultimo = l.ultimo.siguiente
+	}
+}
+
+// mergeSort ordena la cadena de nodos que empieza en primero reenlazándolos, y devuelve el nuevo primero
+func mergeSort[T any](primero *nodoLista[T], cmp func(T, T) int) *nodoLista[T] {
+	if primero == nil || primero.siguiente == nil {
+		return primero
+	}
+
+	// Buscamos la mitad avanzando un puntero al doble de velocidad que el otro
+	lento, rapido := primero, primero.siguiente
+	for rapido != nil && rapido.siguiente != nil {
+		lento = lento.siguiente
+		rapido = rapido.siguiente.siguiente
+	}
+	mitad := lento.siguiente
+	lento.siguiente = nil
+
+	return merge(mergeSort(primero, cmp), mergeSort(mitad, cmp), cmp)
+}
+
+func merge[T any](izq, der *nodoLista[T], cmp func(T, T) int) *nodoLista[T] {
+	var cabecera nodoLista[T]
+	ultimo := &cabecera
+
+	for izq != nil && der != nil {
+		// Con <= tomamos primero los de la izquierda ante empates, para que sea estable
+		if cmp(izq.dato, der.dato) <= 0 {
+			ultimo.siguiente = izq
+			izq = izq.siguiente
+		} else {
+			ultimo.siguiente = der
+			der = der.siguiente
+		}
+		ultimo = ultimo.siguiente
+	}
+
+	if izq != nil {
+		ultimo.siguiente = izq
+	} else {
+		ultimo.siguiente = der
+	}
+	return cabecera.siguiente
+}
+
+func (l *listaEnlazada[T]) InsertarOrdenado(dato T, cmp func(T, T) int) {
+	if l.EstaVacia() || cmp(dato, l.primero.dato) < 0 {
+		l.InsertarPrimero(dato)
+		return
+	}
+	if cmp(dato, l.ultimo.dato) >= 0 {
+		l.InsertarUltimo(dato)
+		return
+	}
+
+	anterior := l.primero
+	for cmp(dato, anterior.siguiente.dato) >= 0 {
+		anterior = anterior.siguiente
+	}
+	nuevoNodo := crearNodo(dato)
+	nuevoNodo.siguiente = anterior.siguiente
+	anterior.siguiente = nuevoNodo
+	l.largo++
+	l.modificaciones++
+}
+
+func (l *listaEnlazada[T]) Concatenar(otra Lista[T]) {
+	if otra == Lista[T](l) {
+		panic(MENSAJE_MISMA_LISTA)
+	}
+
+	primero, ultimo, largo := extraerNodos(otra)
+	if largo == 0 {
+		return
+	}
+
+	if l.EstaVacia() {
+		l.primero = primero
+	} else {
+		l.ultimo.siguiente = primero
+	}
+	l.ultimo = ultimo
+	l.largo += largo
+	l.modificaciones++
+}
+
+// extraerNodos saca todos los nodos de otra dejándola vacía, y devuelve los extremos de la cadena y su largo.
+// Si otra no es una listaEnlazada, se crean nodos nuevos a medida que se vacía.
+func extraerNodos[T any](otra Lista[T]) (*nodoLista[T], *nodoLista[T], int) {
+	if le, ok := otra.(*listaEnlazada[T]); ok {
+		primero, ultimo, largo := le.primero, le.ultimo, le.largo
+		le.primero, le.ultimo, le.largo = nil, nil, 0
+		le.modificaciones++
+		return primero, ultimo, largo
+	}
+
+	aux := &listaEnlazada[T]{}
+	for !otra.EstaVacia() {
+		aux.InsertarUltimo(otra.BorrarPrimero())
+	}
+	return aux.primero, aux.ultimo, aux.largo
+}
+
 func (l *listaEnlazada[T]) Iterar(visitar func(T) bool) {
 	for actual := l.primero; actual != nil; actual = actual.siguiente {
 		if !visitar(actual.dato) {
@@ -115,9 +305,10 @@ func (l *listaEnlazada[T]) Iterar(visitar func(T) bool) {
 
 func (l *listaEnlazada[T]) Iterador() IteradorLista[T] {
 	return &iterListaEnlazada[T]{
-		lista:    l,
-		actual:   l.primero,
-		anterior: nil}
+		lista:          l,
+		actual:         l.primero,
+		anterior:       nil,
+		modificaciones: l.modificaciones}
 }
 
 //Iterador externo
@@ -128,22 +319,38 @@ func (it *iterListaEnlazada[T]) verificarNoTerminado() {
 	}
 }
 
+// verificarNoModificada entra en pánico si la lista se modificó por fuera del iterador desde que se creó,
+// ya que en ese caso actual y anterior pueden no ser válidos
+func (it *iterListaEnlazada[T]) verificarNoModificada() {
+	if it.modificaciones != it.lista.modificaciones {
+		panic(MENSAJE_LISTA_MODIFICADA)
+	}
+}
+
+func (it *iterListaEnlazada[T]) registrarModificacion() {
+	it.lista.modificaciones++
+	it.modificaciones = it.lista.modificaciones
+}
+
 func (it *iterListaEnlazada[T]) HaySiguiente() bool {
 	return it.actual != nil
 }
 
 func (it *iterListaEnlazada[T]) VerActual() T {
+	it.verificarNoModificada()
 	it.verificarNoTerminado()
 	return it.actual.dato
 }
 
 func (it *iterListaEnlazada[T]) Siguiente() {
+	it.verificarNoModificada()
 	it.verificarNoTerminado()
 	it.anterior = it.actual
 	it.actual = it.actual.siguiente
 }
 
 func (it *iterListaEnlazada[T]) Insertar(dato T) {
+	it.verificarNoModificada()
 	nuevo := crearNodo(dato)
 
 	if it.anterior == nil {
@@ -161,9 +368,36 @@ func (it *iterListaEnlazada[T]) Insertar(dato T) {
 	}
 	it.actual = nuevo
 	it.lista.largo++
+	it.registrarModificacion()
+}
+
+func (it *iterListaEnlazada[T]) InsertarLista(otra Lista[T]) {
+	it.verificarNoModificada()
+	if otra == Lista[T](it.lista) {
+		panic(MENSAJE_MISMA_LISTA)
+	}
+
+	primero, ultimo, largo := extraerNodos(otra)
+	if largo == 0 {
+		return
+	}
+
+	ultimo.siguiente = it.actual
+	if it.anterior == nil {
+		it.lista.primero = primero
+	} else {
+		it.anterior.siguiente = primero
+	}
+	if it.actual == nil {
+		it.lista.ultimo = ultimo
+	}
+	it.actual = primero
+	it.lista.largo += largo
+	it.registrarModificacion()
 }
 
 func (it *iterListaEnlazada[T]) Borrar() T {
+	it.verificarNoModificada()
 	it.verificarNoTerminado()
 	dato := it.actual.dato
 
@@ -180,5 +414,6 @@ func (it *iterListaEnlazada[T]) Borrar() T {
 	}
 	it.actual = it.actual.siguiente
 	it.lista.largo--
+	it.registrarModificacion()
 	return dato
 }
@@ -1,6 +1,7 @@
 package lista_test
 
 import (
+	"fmt"
 	TDALista "tdas/lista"
 	"testing"
 
@@ -83,6 +84,323 @@ func TestVolumen(t *testing.T) {
 	require.Equal(t, 0, l.VerUltimo())
 }
 
+// Pruebas acceso por posicion
+func TestObtener(t *testing.T) {
+	l := TDALista.CrearListaEnlazada[int]()
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { l.Obtener(0) })
+
+	for i := 0; i < 5; i++ {
+		l.InsertarUltimo(i * 10)
+	}
+	for i := 0; i < 5; i++ {
+		require.Equal(t, i*10, l.Obtener(i))
+	}
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { l.Obtener(5) })
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { l.Obtener(-1) })
+}
+
+func TestInsertarEn(t *testing.T) {
+	l := TDALista.CrearListaEnlazada[int]()
+	l.InsertarEn(0, 2) // lista vacia
+	l.InsertarEn(0, 0) // al principio
+	l.InsertarEn(2, 4) // al final
+	l.InsertarEn(1, 1) // en el medio
+	l.InsertarEn(3, 3)
+
+	require.Equal(t, 5, l.Largo())
+	require.Equal(t, 0, l.VerPrimero())
+	require.Equal(t, 4, l.VerUltimo())
+	for i := 0; i < 5; i++ {
+		require.Equal(t, i, l.Obtener(i))
+	}
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { l.InsertarEn(6, 9) })
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { l.InsertarEn(-1, 9) })
+	require.Equal(t, 5, l.Largo())
+}
+
+func TestBorrarEn(t *testing.T) {
+	l := TDALista.CrearListaEnlazada[int]()
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { l.BorrarEn(0) })
+
+	for i := 0; i < 5; i++ {
+		l.InsertarUltimo(i)
+	}
+	require.Equal(t, 2, l.BorrarEn(2)) // del medio
+	require.Equal(t, 4, l.BorrarEn(3)) // el ultimo
+	require.Equal(t, 3, l.VerUltimo())
+	require.Equal(t, 0, l.BorrarEn(0)) // el primero
+	require.Equal(t, 1, l.VerPrimero())
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { l.BorrarEn(2) })
+
+	require.Equal(t, 3, l.BorrarEn(1))
+	require.Equal(t, 1, l.BorrarEn(0))
+	require.True(t, l.EstaVacia())
+
+	// La lista sigue siendo usable tras vaciarse
+	l.InsertarUltimo(7)
+	require.Equal(t, 7, l.VerPrimero())
+	require.Equal(t, 7, l.VerUltimo())
+}
+
+func TestBuscar(t *testing.T) {
+	l := TDALista.CrearListaEnlazada[string]()
+	_, encontrado := l.Buscar(func(s string) bool { return true })
+	require.False(t, encontrado)
+
+	for _, v := range []string{"a", "b", "c", "b"} {
+		l.InsertarUltimo(v)
+	}
+	pos, encontrado := l.Buscar(func(s string) bool { return s == "b" })
+	require.True(t, encontrado)
+	require.Equal(t, 1, pos)
+
+	pos, encontrado = l.Buscar(func(s string) bool { return s == "z" })
+	require.False(t, encontrado)
+	require.Equal(t, -1, pos)
+}
+
+// Pruebas ordenamiento
+func cmpInts(a, b int) int {
+	return a - b
+}
+
+func TestOrdenar(t *testing.T) {
+	l := TDALista.CrearListaEnlazada[int]()
+	l.Ordenar(cmpInts)
+	require.True(t, l.EstaVacia())
+
+	for _, v := range []int{5, 3, 9, 1, 7, 3, 0} {
+		l.InsertarUltimo(v)
+	}
+	l.Ordenar(cmpInts)
+
+	arr := []int{}
+	l.Iterar(func(v int) bool {
+		arr = append(arr, v)
+		return true
+	})
+	require.Equal(t, []int{0, 1, 3, 3, 5, 7, 9}, arr)
+	require.Equal(t, 7, l.Largo())
+	require.Equal(t, 0, l.VerPrimero())
+	require.Equal(t, 9, l.VerUltimo())
+
+	// El ultimo queda bien enlazado
+	l.InsertarUltimo(10)
+	require.Equal(t, 10, l.Obtener(7))
+}
+
+func TestOrdenarEsEstable(t *testing.T) {
+	type par struct {
+		clave, orden int
+	}
+	l := TDALista.CrearListaEnlazada[par]()
+	for i, c := range []int{2, 1, 2, 1, 0, 2} {
+		l.InsertarUltimo(par{c, i})
+	}
+	l.Ordenar(func(a, b par) int { return a.clave - b.clave })
+
+	arr := []par{}
+	l.Iterar(func(p par) bool {
+		arr = append(arr, p)
+		return true
+	})
+	require.Equal(t, []par{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}, {2, 5}}, arr)
+}
+
+func TestOrdenarVolumen(t *testing.T) {
+	const n = 10000
+	l := TDALista.CrearListaEnlazada[int]()
+	for i := 0; i < n; i++ {
+		l.InsertarPrimero(i)
+	}
+	l.Ordenar(cmpInts)
+
+	cont := 0
+	l.Iterar(func(v int) bool {
+		require.Equal(t, cont, v)
+		cont++
+		return true
+	})
+	require.Equal(t, n, cont)
+	require.Equal(t, n-1, l.VerUltimo())
+}
+
+func TestInsertarOrdenado(t *testing.T) {
+	l := TDALista.CrearListaEnlazada[int]()
+	for _, v := range []int{5, 1, 9, 5, 3, 0, 10} {
+		l.InsertarOrdenado(v, cmpInts)
+	}
+
+	arr := []int{}
+	l.Iterar(func(v int) bool {
+		arr = append(arr, v)
+		return true
+	})
+	require.Equal(t, []int{0, 1, 3, 5, 5, 9, 10}, arr)
+	require.Equal(t, 7, l.Largo())
+	require.Equal(t, 0, l.VerPrimero())
+	require.Equal(t, 10, l.VerUltimo())
+}
+
+// Pruebas operaciones
+func crearListaCon[T any](valores ...T) TDALista.Lista[T] {
+	l := TDALista.CrearListaEnlazada[T]()
+	for _, v := range valores {
+		l.InsertarUltimo(v)
+	}
+	return l
+}
+
+func aSlice[T any](l TDALista.Lista[T]) []T {
+	arr := []T{}
+	l.Iterar(func(v T) bool {
+		arr = append(arr, v)
+		return true
+	})
+	return arr
+}
+
+func TestMapearFiltrarReducir(t *testing.T) {
+	l := crearListaCon(1, 2, 3, 4, 5)
+
+	cadenas := TDALista.Mapear(l, func(v int) string { return fmt.Sprintf("n%d", v) })
+	require.Equal(t, []string{"n1", "n2", "n3", "n4", "n5"}, aSlice(cadenas))
+
+	pares := TDALista.Filtrar(l, func(v int) bool { return v%2 == 0 })
+	require.Equal(t, []int{2, 4}, aSlice(pares))
+	require.Equal(t, 4, pares.VerUltimo())
+
+	suma := TDALista.Reducir(l, 0, func(acum, v int) int { return acum + v })
+	require.Equal(t, 15, suma)
+
+	// La original no se modifica
+	require.Equal(t, []int{1, 2, 3, 4, 5}, aSlice(l))
+
+	vacia := TDALista.CrearListaEnlazada[int]()
+	require.True(t, TDALista.Mapear(vacia, func(v int) int { return v }).EstaVacia())
+	require.True(t, TDALista.Filtrar(vacia, func(v int) bool { return true }).EstaVacia())
+	require.Equal(t, 7, TDALista.Reducir(vacia, 7, func(acum, v int) int { return acum + v }))
+}
+
+func TestInvertir(t *testing.T) {
+	l := crearListaCon(1, 2, 3)
+	TDALista.Invertir(l)
+	require.Equal(t, []int{3, 2, 1}, aSlice(l))
+	require.Equal(t, 3, l.VerPrimero())
+	require.Equal(t, 1, l.VerUltimo())
+
+	l.InsertarUltimo(0)
+	require.Equal(t, []int{3, 2, 1, 0}, aSlice(l))
+
+	vacia := TDALista.CrearListaEnlazada[int]()
+	TDALista.Invertir(vacia)
+	require.True(t, vacia.EstaVacia())
+}
+
+func TestConcatenar(t *testing.T) {
+	l1 := crearListaCon(1, 2)
+	l2 := TDALista.CrearListaEnlazada[int]()
+	l3 := crearListaCon(3, 4, 5)
+
+	res := TDALista.Concatenar(l1, l2, l3)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, aSlice(res))
+	require.Equal(t, 5, res.Largo())
+	require.Equal(t, 5, res.VerUltimo())
+	require.True(t, l1.EstaVacia())
+	require.True(t, l3.EstaVacia())
+
+	// Las listas vaciadas siguen siendo usables
+	l1.InsertarUltimo(9)
+	require.Equal(t, []int{9}, aSlice(l1))
+	require.Equal(t, []int{1, 2, 3, 4, 5}, aSlice(res))
+}
+
+func TestConcatenarMetodo(t *testing.T) {
+	l := crearListaCon(1, 2)
+	otra := crearListaCon(3, 4)
+
+	l.Concatenar(otra)
+	require.Equal(t, []int{1, 2, 3, 4}, aSlice(l))
+	require.Equal(t, 4, l.Largo())
+	require.Equal(t, 4, l.VerUltimo())
+	require.True(t, otra.EstaVacia())
+
+	// Concatenar una vacia no cambia nada
+	l.Concatenar(otra)
+	require.Equal(t, 4, l.Largo())
+
+	// Concatenar sobre una vacia
+	otra.Concatenar(l)
+	require.Equal(t, []int{1, 2, 3, 4}, aSlice(otra))
+	require.Equal(t, 1, otra.VerPrimero())
+	require.True(t, l.EstaVacia())
+
+	otra.InsertarUltimo(5)
+	require.Equal(t, 5, otra.VerUltimo())
+	require.PanicsWithValue(t, "No se puede concatenar una lista consigo misma", func() { otra.Concatenar(otra) })
+}
+
+func TestIteradorExtInsertarLista(t *testing.T) {
+	l := crearListaCon(1, 5)
+
+	// En el medio
+	iter := l.Iterador()
+	iter.Siguiente()
+	iter.InsertarLista(crearListaCon(2, 3, 4))
+	require.Equal(t, 2, iter.VerActual())
+	require.Equal(t, []int{1, 2, 3, 4, 5}, aSlice(l))
+	require.Equal(t, 5, l.Largo())
+
+	// Al principio
+	iter = l.Iterador()
+	iter.InsertarLista(crearListaCon(0))
+	require.Equal(t, 0, l.VerPrimero())
+
+	// Al final
+	for iter.HaySiguiente() {
+		iter.Siguiente()
+	}
+	otra := crearListaCon(6, 7)
+	iter.InsertarLista(otra)
+	require.True(t, otra.EstaVacia())
+	require.Equal(t, 7, l.VerUltimo())
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, aSlice(l))
+
+	// Una lista vacia no mueve el iterador
+	iter.InsertarLista(otra)
+	require.Equal(t, 6, iter.VerActual())
+	require.Equal(t, 8, l.Largo())
+
+	// Sobre una lista vacia
+	vacia := TDALista.CrearListaEnlazada[int]()
+	vacia.Iterador().InsertarLista(crearListaCon(1, 2))
+	require.Equal(t, 1, vacia.VerPrimero())
+	require.Equal(t, 2, vacia.VerUltimo())
+
+	require.PanicsWithValue(t, "No se puede concatenar una lista consigo misma", func() { iter.InsertarLista(l) })
+}
+
+func TestPartir(t *testing.T) {
+	l := crearListaCon(1, 2, 3, 4, 5)
+	resto := TDALista.Partir(l, 2)
+	require.Equal(t, []int{1, 2}, aSlice(l))
+	require.Equal(t, []int{3, 4, 5}, aSlice(resto))
+	require.Equal(t, 2, l.VerUltimo())
+	require.Equal(t, 5, resto.VerUltimo())
+	require.Equal(t, 3, resto.Largo())
+
+	vacio := TDALista.Partir(l, 2)
+	require.True(t, vacio.EstaVacia())
+	require.Equal(t, 2, l.Largo())
+
+	todo := TDALista.Partir(l, 0)
+	require.True(t, l.EstaVacia())
+	require.Equal(t, []int{1, 2}, aSlice(todo))
+
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { TDALista.Partir(todo, 3) })
+	require.PanicsWithValue(t, "La posicion esta fuera de rango", func() { TDALista.Partir(todo, -1) })
+}
+
 // Pruebas iterador interno
 func TestIteradorInterno(t *testing.T) {
 	l := TDALista.CrearListaEnlazada[int]()
@@ -204,6 +522,38 @@ func TestIteradorExtPanics(t *testing.T) {
 	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Borrar() })
 }
 
+func TestIteradorExtListaModificada(t *testing.T) {
+	l := crearListaCon(1, 2, 3)
+
+	iter := l.Iterador()
+	iter.Siguiente()
+	l.InsertarPrimero(0)
+	require.PanicsWithValue(t, "La lista fue modificada durante la iteracion", func() { iter.VerActual() })
+	require.PanicsWithValue(t, "La lista fue modificada durante la iteracion", func() { iter.Siguiente() })
+	require.PanicsWithValue(t, "La lista fue modificada durante la iteracion", func() { iter.Insertar(9) })
+	require.PanicsWithValue(t, "La lista fue modificada durante la iteracion", func() { iter.Borrar() })
+
+	iter = l.Iterador()
+	l.BorrarPrimero()
+	require.PanicsWithValue(t, "La lista fue modificada durante la iteracion", func() { iter.VerActual() })
+
+	// Las modificaciones hechas por el propio iterador no lo invalidan, pero si a los demas
+	iter = l.Iterador()
+	otro := l.Iterador()
+	iter.Borrar()
+	iter.Insertar(5)
+	require.Equal(t, 5, iter.VerActual())
+	require.PanicsWithValue(t, "La lista fue modificada durante la iteracion", func() { otro.VerActual() })
+
+	// Mover los nodos de una lista a otra invalida a los iteradores de ambas
+	iter = l.Iterador()
+	otra := crearListaCon(7)
+	iterOtra := otra.Iterador()
+	l.Concatenar(otra)
+	require.PanicsWithValue(t, "La lista fue modificada durante la iteracion", func() { iter.VerActual() })
+	require.PanicsWithValue(t, "La lista fue modificada durante la iteracion", func() { iterOtra.VerActual() })
+}
+
 func TestIteradorExtVolumen(t *testing.T) {
 	const n = 10000
 	l := TDALista.CrearListaEnlazada[int]()
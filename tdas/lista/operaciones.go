@@ -0,0 +1,114 @@
+package lista
+
+// Operaciones genéricas sobre listas. Cuando la lista es una listaEnlazada se reenlazan los nodos directamente
+// en lugar de copiar los elementos.
+
+// Mapear devuelve una lista nueva con el resultado de aplicar f a cada elemento de l, en el mismo orden.
+func Mapear[T any, U any](l Lista[T], f func(T) U) Lista[U] {
+	resultado := CrearListaEnlazada[U]()
+	l.Iterar(func(dato T) bool {
+		resultado.InsertarUltimo(f(dato))
+		return true
+	})
+	return resultado
+}
+
+// Filtrar devuelve una lista nueva con los elementos de l para los cuales pred devuelve true, en el mismo orden.
+func Filtrar[T any](l Lista[T], pred func(T) bool) Lista[T] {
+	resultado := CrearListaEnlazada[T]()
+	l.Iterar(func(dato T) bool {
+		if pred(dato) {
+			resultado.InsertarUltimo(dato)
+		}
+		return true
+	})
+	return resultado
+}
+
+// Reducir acumula los elementos de l de izquierda a derecha aplicando f, partiendo del valor inicial.
+func Reducir[T any, U any](l Lista[T], inicial U, f func(U, T) U) U {
+	acumulado := inicial
+	l.Iterar(func(dato T) bool {
+		acumulado = f(acumulado, dato)
+		return true
+	})
+	return acumulado
+}
+
+// Invertir da vuelta el orden de los elementos de l, modificándola.
+func Invertir[T any](l Lista[T]) {
+	if le, ok := l.(*listaEnlazada[T]); ok {
+		var anterior *nodoLista[T]
+		actual := le.primero
+		for actual != nil {
+			siguiente := actual.siguiente
+			actual.siguiente = anterior
+			anterior = actual
+			actual = siguiente
+		}
+		le.primero, le.ultimo = le.ultimo, le.primero
+		le.modificaciones++
+		return
+	}
+
+	aux := CrearListaEnlazada[T]()
+	for !l.EstaVacia() {
+		aux.InsertarPrimero(l.BorrarPrimero())
+	}
+	for !aux.EstaVacia() {
+		l.InsertarUltimo(aux.BorrarPrimero())
+	}
+}
+
+// Concatenar devuelve una lista con los elementos de todas las listas recibidas, en orden. Los elementos se
+// mueven a la nueva lista, por lo que las listas recibidas quedan vacías.
+func Concatenar[T any](listas ...Lista[T]) Lista[T] {
+	resultado := CrearListaEnlazada[T]()
+	for _, l := range listas {
+		resultado.Concatenar(l)
+	}
+	return resultado
+}
+
+// Partir corta la lista l en la posición en: l se queda con los primeros en elementos y se devuelve una lista
+// nueva con el resto. Si la posición no está entre 0 y Largo(), entra en pánico con un mensaje
+// "La posicion esta fuera de rango".
+func Partir[T any](l Lista[T], en int) Lista[T] {
+	if en < 0 || en > l.Largo() {
+		panic(MENSAJE_FUERA_DE_RANGO)
+	}
+
+	resto := CrearListaEnlazada[T]().(*listaEnlazada[T])
+	le, ok := l.(*listaEnlazada[T])
+	if !ok {
+		aux := CrearListaEnlazada[T]()
+		for i := 0; i < en; i++ {
+			aux.InsertarUltimo(l.BorrarPrimero())
+		}
+		for !l.EstaVacia() {
+			resto.InsertarUltimo(l.BorrarPrimero())
+		}
+		for !aux.EstaVacia() {
+			l.InsertarUltimo(aux.BorrarPrimero())
+		}
+		return resto
+	}
+
+	if en == le.largo {
+		return resto
+	}
+	if en == 0 {
+		resto.primero, resto.ultimo, resto.largo = le.primero, le.ultimo, le.largo
+		le.primero, le.ultimo, le.largo = nil, nil, 0
+		le.modificaciones++
+		return resto
+	}
+
+	nuevoUltimo := le.nodoEn(en - 1)
+	resto.primero, resto.ultimo, resto.largo = nuevoUltimo.siguiente, le.ultimo, le.largo-en
+	nuevoUltimo.siguiente = nil
+	le.ultimo = nuevoUltimo
+	le.largo = en
+	le.modificaciones++
+	return resto
+}